@@ -0,0 +1,130 @@
+package converter
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Candidate 數字時間戳的一種合理解讀
+type Candidate struct {
+	Format TimestampFormat `json:"-"`
+	Name   string          `json:"format"`
+	Time   string          `json:"time"`
+
+	// Score 合理程度，介於 0 與 1 之間；越接近參考時間分數越高
+	Score float64 `json:"score"`
+}
+
+// DefaultPlausibleStart、DefaultPlausibleEnd 未設定 Converter.PlausibleStart/End 時的合理時間範圍
+var (
+	DefaultPlausibleStart = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	DefaultPlausibleEnd   = time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// 判斷候選是否無法確定的門檻
+const (
+	// ambiguityRatio 第二名候選的分數達到第一名的此比例時視為無法確定
+	ambiguityRatio = 0.75
+
+	// minConfidentScore 第一名候選的分數低於此值 (離參考時間太遠) 且有其他候選時視為無法確定
+	minConfidentScore = 0.25
+
+	// finerPrecisionPenalty 已有較粗且合理的 Unix 精確度時，較細精確度的分數乘數
+	// 須小於 ambiguityRatio，較細的解讀才不會單獨讓較粗者變得無法確定
+	finerPrecisionPenalty = 0.5
+)
+
+// candidateFormats 數字輸入的候選格式，順序同時決定同分時的排名
+// Unix 時間戳由粗到細排列；WebKit、FILETIME 與 .NET ticks 只適用於不帶正負號與小數的整數
+var candidateFormats = []struct {
+	format    TimestampFormat
+	unix      bool
+	plainOnly bool
+}{
+	{UnixSeconds(), true, false},
+	{UnixMilliseconds(), true, false},
+	{UnixMicroseconds(), true, false},
+	{UnixNanoseconds(), true, false},
+	{WebKit(), false, true},
+	{FileTime(), false, true},
+	{DotNetTicks(), false, true},
+}
+
+// plausibleWindow 取得自動偵測視為合理的時間範圍
+func (c *Converter) plausibleWindow() (time.Time, time.Time) {
+	start, end := c.PlausibleStart, c.PlausibleEnd
+	if start.IsZero() {
+		start = DefaultPlausibleStart
+	}
+	if end.IsZero() {
+		end = DefaultPlausibleEnd
+	}
+	return start, end
+}
+
+// DetectCandidates 列出數字輸入落在合理時間範圍內的所有解讀，依分數由高至低排序
+// 分數依與參考時間 (Converter.Now) 的距離線性遞減，範圍邊緣中距離較遠者為 0
+// 同一串數字以更細的 Unix 精確度解讀必然緊貼 1970-01-01，這正是常見的誤判 (如秒級時間戳被當成毫秒)，
+// 因此已有較粗且合理的精確度時，較細者的分數取兩者較低者再乘以 finerPrecisionPenalty，
+// 仍會列出但排在較粗者之後；只有較粗的解讀本身離參考時間很遠時，Strict 模式才會因此回報無法確定
+// 非數字或數值為 0 的輸入回傳 nil
+func (c *Converter) DetectCandidates(input string) []Candidate {
+	input = strings.TrimSpace(input)
+	if !decimalPattern.MatchString(input) || strings.Trim(input, "+-0.") == "" {
+		return nil
+	}
+	plain := isPlainInteger(input)
+
+	start, end := c.plausibleWindow()
+	ref := c.now()
+	span := math.Max(unixSeconds(ref)-unixSeconds(start), unixSeconds(end)-unixSeconds(ref))
+
+	var candidates []Candidate
+	coarser := -1.0 // 上一個合理的 Unix 精確度的分數，尚未出現時為負數
+	for _, f := range candidateFormats {
+		if f.plainOnly && !plain {
+			continue
+		}
+		t, err := c.Parse(input, f.format)
+		if err != nil || t.Before(start) || !t.Before(end) {
+			continue
+		}
+		score := math.Max(1-math.Abs(unixSeconds(t)-unixSeconds(ref))/span, 0)
+		if f.unix {
+			if coarser >= 0 {
+				score = math.Min(score, coarser) * finerPrecisionPenalty
+			}
+			coarser = score
+		}
+		candidates = append(candidates, Candidate{
+			Format: f.format,
+			Name:   f.format.String(),
+			Time:   t.Format(time.RFC3339Nano),
+			Score:  math.Round(score*1000) / 1000,
+		})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	return candidates
+}
+
+// Ambiguous 判斷依分數排序的候選中是否沒有明顯較合理的一個
+// 沒有候選、第一名的分數過低，或第二名與第一名的分數相近時為 true；只有一個候選時為 false
+func Ambiguous(candidates []Candidate) bool {
+	switch len(candidates) {
+	case 0:
+		return true
+	case 1:
+		return false
+	}
+	top, second := candidates[0].Score, candidates[1].Score
+	return top < minConfidentScore || second >= top*ambiguityRatio
+}
+
+// unixSeconds 以浮點數表示的 Unix 秒數，避免 time.Duration 在數百年的差距下溢位
+func unixSeconds(t time.Time) float64 {
+	return float64(t.Unix()) + float64(t.Nanosecond())/1e9
+}
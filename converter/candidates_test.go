@@ -0,0 +1,192 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// newCandidateConverter 建立參考時間固定為 2022-01-24 的轉換器
+func newCandidateConverter() *Converter {
+	conv, _ := NewConverter("UTC")
+	conv.Now = func() time.Time { return time.Date(2022, 1, 24, 16, 7, 14, 0, time.UTC) }
+	return conv
+}
+
+func TestDetectCandidates(t *testing.T) {
+	conv := newCandidateConverter()
+
+	tests := []struct {
+		name  string
+		input string
+		want  []TimestampFormat
+	}{
+		{"seconds outrank 1970 milliseconds", "1642781234", []TimestampFormat{UnixSeconds(), UnixMilliseconds(), UnixMicroseconds(), UnixNanoseconds()}},
+		{"11-digit milliseconds from 1973", "99999999999", []TimestampFormat{UnixMilliseconds(), UnixMicroseconds(), UnixNanoseconds()}},
+		{"12-digit milliseconds from 1973", "123456789012", []TimestampFormat{UnixMilliseconds(), UnixMicroseconds(), UnixNanoseconds()}},
+		{"15-digit microseconds", "100000000000000", []TimestampFormat{UnixMicroseconds(), UnixNanoseconds()}},
+		{"FILETIME outranks 1974 nanoseconds", "132872548340000000", []TimestampFormat{FileTime(), UnixNanoseconds()}},
+		{"1973 nanoseconds outrank 1970 FILETIME", "116444736000000000", []TimestampFormat{UnixNanoseconds(), FileTime()}},
+		{"signed input skips ticks", "+132872548340000000", []TimestampFormat{UnixNanoseconds()}},
+		{"before window", "-86400", nil},
+		{"zero", "0", nil},
+		{"not numeric", "2022-01-24", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := conv.DetectCandidates(tt.input)
+			if len(got) != len(tt.want) {
+				t.Fatalf("DetectCandidates(%q) = %+v, want formats %v", tt.input, got, tt.want)
+			}
+			for i, c := range got {
+				if c.Format != tt.want[i] {
+					t.Errorf("candidate %d = %v, want %v", i, c.Format, tt.want[i])
+				}
+				if c.Score < 0 || c.Score > 1 || (i > 0 && c.Score > got[i-1].Score) {
+					t.Errorf("candidate %d score = %v, not ranked", i, c.Score)
+				}
+			}
+		})
+	}
+}
+
+func TestDetectCandidatesFinerPrecision(t *testing.T) {
+	conv := newCandidateConverter()
+
+	// 較細的精確度不超過較粗者分數的一半
+	got := conv.DetectCandidates("1642781234")
+	if len(got) != 4 {
+		t.Fatalf("DetectCandidates(1642781234) = %+v", got)
+	}
+	if got[0].Score != 1 || got[1].Score != 0.166 || got[1].Time != "1970-01-20T00:19:41.234Z" {
+		t.Errorf("seconds, milliseconds = %+v, %+v", got[0], got[1])
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i].Score > got[i-1].Score*finerPrecisionPenalty+0.001 {
+			t.Errorf("candidate %d score = %v, want at most half of %v", i, got[i].Score, got[i-1].Score)
+		}
+	}
+
+	// 2096 年的秒級解讀離參考時間很遠，分數過低而無法確定
+	got = conv.DetectCandidates("4000000000")
+	if len(got) != 4 || got[0].Format != UnixSeconds() || got[1].Format != UnixMilliseconds() {
+		t.Fatalf("DetectCandidates(4000000000) = %+v", got)
+	}
+	if !Ambiguous(got) {
+		t.Errorf("DetectCandidates(4000000000) = %+v, want ambiguous", got)
+	}
+}
+
+func TestDetectCandidatesWindow(t *testing.T) {
+	conv := newCandidateConverter()
+	conv.PlausibleStart = time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+	conv.PlausibleEnd = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got := conv.DetectCandidates("-2208988800")
+	if len(got) == 0 || got[0].Format != UnixSeconds() || got[0].Time != "1900-01-01T00:00:00Z" {
+		t.Errorf("DetectCandidates(1900) = %+v", got)
+	}
+
+	// 2033 年超出範圍，排名第一的是 1970 年的毫秒解讀
+	got = conv.DetectCandidates("2000000000")
+	if len(got) != 3 || got[0].Format != UnixMilliseconds() {
+		t.Errorf("DetectCandidates(2033) = %+v", got)
+	}
+}
+
+func TestAmbiguous(t *testing.T) {
+	tests := []struct {
+		name   string
+		scores []float64
+		want   bool
+	}{
+		{"no candidates", nil, true},
+		{"single far candidate", []float64{0.1}, false},
+		{"clear winner", []float64{0.9, 0.4}, false},
+		{"finer precision at half score", []float64{0.373, 0.186}, false},
+		{"close scores", []float64{0.379, 0.332}, true},
+		{"low top score", []float64{0.2, 0.05}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := make([]Candidate, len(tt.scores))
+			for i, score := range tt.scores {
+				candidates[i].Score = score
+			}
+			if got := Ambiguous(candidates); got != tt.want {
+				t.Errorf("Ambiguous(%v) = %v, want %v", tt.scores, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrictDetection(t *testing.T) {
+	conv := newCandidateConverter()
+	conv.Strict = true
+
+	// 差距明顯時 Strict 模式仍採用排名第一的解讀
+	if format, err := conv.DetectFormat("1642781234"); err != nil || format != UnixSeconds() {
+		t.Errorf("DetectFormat(1642781234) = %v, %v", format, err)
+	}
+	// 1973 年的毫秒不會因 1970 年的微秒與納秒解讀而無法確定
+	if format, err := conv.DetectFormat("100000000000"); err != nil || format != UnixMilliseconds() {
+		t.Errorf("DetectFormat(100000000000) = %v, %v", format, err)
+	}
+	if format, err := conv.DetectFormat("15000000000"); err != nil || format != UnixMilliseconds() {
+		t.Errorf("DetectFormat(15000000000) = %v, %v", format, err)
+	}
+
+	tests := []struct {
+		input      string
+		candidates int
+	}{
+		{"116444736000000000", 2},
+		{"4000000000", 4},
+		{"-86400", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := conv.DetectFormat(tt.input)
+			var ambiguous *AmbiguousError
+			if !errors.As(err, &ambiguous) || !errors.Is(err, ErrAmbiguousFormat) {
+				t.Fatalf("DetectFormat(%q) error = %v, want *AmbiguousError", tt.input, err)
+			}
+			if len(ambiguous.Candidates) != tt.candidates {
+				t.Errorf("Candidates = %+v, want %d", ambiguous.Candidates, tt.candidates)
+			}
+		})
+	}
+
+	// 非 Strict 模式照常猜測
+	conv.Strict = false
+	if format, err := conv.DetectFormat("116444736000000000"); err != nil || format != UnixNanoseconds() {
+		t.Errorf("DetectFormat(116444736000000000) = %v, %v", format, err)
+	}
+	if format, err := conv.DetectFormat("4000000000"); err != nil || format != UnixSeconds() {
+		t.Errorf("DetectFormat(4000000000) = %v, %v", format, err)
+	}
+	if format, err := conv.DetectFormat("-86400"); err != nil || format != UnixSeconds() {
+		t.Errorf("DetectFormat(-86400) = %v, %v", format, err)
+	}
+}
+
+func TestConvertReportsCandidates(t *testing.T) {
+	conv := newCandidateConverter()
+
+	result, err := conv.Convert("1642781234", nil)
+	if err != nil {
+		t.Fatalf("Convert error = %v", err)
+	}
+	if len(result.Candidates) != 4 || result.Candidates[0].Time != "2022-01-21T16:07:14Z" {
+		t.Errorf("Candidates = %+v", result.Candidates)
+	}
+
+	// 指定輸入格式時不進行偵測
+	format := UnixSeconds()
+	result, _ = conv.Convert("1642781234", &format)
+	if result.Candidates != nil {
+		t.Errorf("Candidates with explicit format = %+v", result.Candidates)
+	}
+}
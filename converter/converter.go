@@ -0,0 +1,711 @@
+package converter
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// TimestampFormat 定義支援的時間格式
+// 內建格式由 UnixSeconds() 等函式取得；自訂格式由 LayoutFormat 或 StrftimeFormat 建立，版面保存在值本身
+// TimestampFormat 可用 == 比較，也可作為 map 的鍵，零值為未知格式
+type TimestampFormat struct {
+	id      int    // 內建格式的編號，自訂格式為 0
+	layout  string // 自訂格式的 Go 參考版面，實際用於解析
+	pattern string // 自訂格式的原始樣式 (strftime 時與 layout 不同)
+	kind    string // 自訂格式的種類，"layout" 或 "strftime"
+}
+
+// 內建格式，每次呼叫都回傳新的值
+// 解析、偵測與名稱查詢只依賴格式內部的編號，呼叫端無法藉由重新賦值改變套件的行為
+func UnixSeconds() TimestampFormat       { return TimestampFormat{id: 1} }
+func UnixMilliseconds() TimestampFormat  { return TimestampFormat{id: 2} }
+func UnixMicroseconds() TimestampFormat  { return TimestampFormat{id: 3} }
+func UnixNanoseconds() TimestampFormat   { return TimestampFormat{id: 4} }
+func RFC3339() TimestampFormat           { return TimestampFormat{id: 5} }
+func RFC3339Nano() TimestampFormat       { return TimestampFormat{id: 6} }
+func DateTime() TimestampFormat          { return TimestampFormat{id: 7} }
+func DateOnly() TimestampFormat          { return TimestampFormat{id: 8} }
+func TimeOnly() TimestampFormat          { return TimestampFormat{id: 9} }
+func NaturalLanguage() TimestampFormat   { return TimestampFormat{id: 10} }
+func FileTime() TimestampFormat          { return TimestampFormat{id: 11} }
+func DotNetTicks() TimestampFormat       { return TimestampFormat{id: 12} }
+func LDAP() TimestampFormat              { return TimestampFormat{id: 13} }
+func Cocoa() TimestampFormat             { return TimestampFormat{id: 14} }
+func HFSPlus() TimestampFormat           { return TimestampFormat{id: 15} }
+func WebKit() TimestampFormat            { return TimestampFormat{id: 16} }
+func GPS() TimestampFormat               { return TimestampFormat{id: 17} }
+func JulianDay() TimestampFormat         { return TimestampFormat{id: 18} }
+func ModifiedJulianDay() TimestampFormat { return TimestampFormat{id: 19} }
+func ExcelSerial() TimestampFormat       { return TimestampFormat{id: 20} }
+func ExcelSerial1904() TimestampFormat   { return TimestampFormat{id: 21} }
+func Snowflake() TimestampFormat         { return TimestampFormat{id: 22} }
+func ULID() TimestampFormat              { return TimestampFormat{id: 23} }
+func UUID() TimestampFormat              { return TimestampFormat{id: 24} }
+func KSUID() TimestampFormat             { return TimestampFormat{id: 25} }
+func ObjectID() TimestampFormat          { return TimestampFormat{id: 26} }
+func RFC1123() TimestampFormat           { return TimestampFormat{id: 27} }
+func RFC822() TimestampFormat            { return TimestampFormat{id: 28} }
+func ANSIC() TimestampFormat             { return TimestampFormat{id: 29} }
+func UnixDate() TimestampFormat          { return TimestampFormat{id: 30} }
+func GoString() TimestampFormat          { return TimestampFormat{id: 31} }
+func ISO8601() TimestampFormat           { return TimestampFormat{id: 32} }
+func Syslog() TimestampFormat            { return TimestampFormat{id: 33} }
+func CLF() TimestampFormat               { return TimestampFormat{id: 34} }
+func LogDateTime() TimestampFormat       { return TimestampFormat{id: 35} }
+func Klog() TimestampFormat              { return TimestampFormat{id: 36} }
+
+// Converter 時間戳轉換器
+type Converter struct {
+	Location *time.Location
+
+	// Now 取得參考時間，用於相對時間與僅含時間的輸入；nil 時使用 time.Now
+	Now func() time.Time
+
+	// Granularity 相對時間描述的最小單位 (見 RelativeUnits)，空字串為秒
+	Granularity string
+
+	// Zones 額外要顯示的時區，非空時 Convert 會填入 ConvertResult.Zones
+	Zones []*time.Location
+
+	// Snowflake 解析 Snowflake ID 使用的紀元，零值為 Twitter 紀元
+	Snowflake SnowflakeEpoch
+
+	// PlausibleStart、PlausibleEnd 自動偵測數字時間戳時視為合理的時間範圍
+	// 零值分別使用 DefaultPlausibleStart 與 DefaultPlausibleEnd
+	PlausibleStart time.Time
+	PlausibleEnd   time.Time
+
+	// Strict 數字時間戳沒有唯一合理解讀時，DetectFormat 回傳 *AmbiguousError 而不猜測
+	Strict bool
+}
+
+// now 取得參考時間
+func (c *Converter) now() time.Time {
+	if c.Now != nil {
+		return c.Now().In(c.Location)
+	}
+	return time.Now().In(c.Location)
+}
+
+// NewConverter 建立新的轉換器
+func NewConverter(timezone string) (*Converter, error) {
+	loc, err := LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Converter{Location: loc}, nil
+}
+
+// LoadLocation 載入時區，空字串與 "Local" 皆代表本機時區
+func LoadLocation(timezone string) (*time.Location, error) {
+	if timezone == "" || timezone == "Local" {
+		return time.Local, nil
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, &TimezoneError{Name: timezone, Err: err}
+	}
+	return loc, nil
+}
+
+// GetLocalTimezone 取得本機時區名稱
+func GetLocalTimezone() string {
+	// 取得本機時區名稱
+	name, _ := time.Now().Zone()
+	if name == "" {
+		// 如果無法取得名稱，嘗試取得完整的時區資訊
+		zone := time.Now().Location().String()
+		if zone == "Local" {
+			// 嘗試從系統環境變數取得
+			if tz := os.Getenv("TZ"); tz != "" {
+				return tz
+			}
+			return "Local"
+		}
+		return zone
+	}
+	return name
+}
+
+// ParseTimeOffset 解析相對時間偏移
+// 月與年分別以 30 天與 365 天近似，需要日曆語意時請使用 AddTimeOffset
+func ParseTimeOffset(offset string) (time.Duration, error) {
+	o, err := ParseOffset(offset)
+	if err != nil {
+		return 0, err
+	}
+	return o.Approximate(), nil
+}
+
+// AddTimeOffset 為時間添加偏移量
+// 支援串接的偏移 (如 -1d6h)、小數 (如 1.5h) 與 ISO 8601 期間 (如 P1DT2H)
+func (c *Converter) AddTimeOffset(baseTime time.Time, offset string) (time.Time, error) {
+	if strings.TrimSpace(offset) == "" {
+		return baseTime, nil
+	}
+
+	o, err := ParseOffset(offset)
+	if err != nil {
+		return baseTime, err
+	}
+
+	return o.Apply(baseTime).In(c.Location), nil
+}
+
+// DetectFormat 自動偵測輸入的時間格式
+func (c *Converter) DetectFormat(input string) (TimestampFormat, error) {
+	input = strings.TrimSpace(input)
+
+	// 檢查是否為數字 (Unix timestamp)，允許正負號與小數
+	if decimalPattern.MatchString(input) {
+		return c.detectNumeric(input)
+	}
+
+	// 檢查 HTTP、郵件、date 指令與 Go time.String() 的文字日期
+	// 需在日期時間格式之前，Go time.String() 的開頭與日期時間格式相同
+	if format, ok := detectTextDate(input); ok {
+		return format, nil
+	}
+
+	// 檢查 syslog、CLF 等日誌中常見的時間
+	if format, ok := detectLogFormat(input); ok {
+		return format, nil
+	}
+
+	// 檢查 RFC3339 格式
+	if matched, _ := regexp.MatchString(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$`, input); matched {
+		if strings.Contains(input, ".") {
+			return RFC3339Nano(), nil
+		}
+		return RFC3339(), nil
+	}
+
+	// 檢查日期時間格式
+	if matched, _ := regexp.MatchString(`^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}$`, input); matched {
+		return DateTime(), nil
+	}
+
+	// 檢查日期格式
+	if matched, _ := regexp.MatchString(`^\d{4}-\d{2}-\d{2}$`, input); matched {
+		return DateOnly(), nil
+	}
+
+	// 檢查時間格式
+	if matched, _ := regexp.MatchString(`^\d{2}:\d{2}:\d{2}$`, input); matched {
+		return TimeOnly(), nil
+	}
+
+	// 檢查其他 ISO 8601 形式 (週日期、序數日期、基本格式、省略秒數、逗號小數等)
+	if isISO8601(input) {
+		return ISO8601(), nil
+	}
+
+	// 檢查含有時間的 ID (ULID、UUID、KSUID、ObjectID)
+	if format, ok := detectID(input); ok {
+		return format, nil
+	}
+
+	// 檢查自然語言相對時間
+	if _, err := c.ParseNatural(input, c.now()); err == nil {
+		return NaturalLanguage(), nil
+	}
+
+	return TimestampFormat{}, &FormatError{Input: input}
+}
+
+// Parse 解析輸入的時間字串
+func (c *Converter) Parse(input string, format TimestampFormat) (time.Time, error) {
+	input = strings.TrimSpace(input)
+
+	switch format {
+	case UnixSeconds():
+		t, err := c.parseUnix(input, int64(time.Second))
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case UnixMilliseconds():
+		t, err := c.parseUnix(input, int64(time.Millisecond))
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case UnixMicroseconds():
+		t, err := c.parseUnix(input, int64(time.Microsecond))
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case UnixNanoseconds():
+		t, err := c.parseUnix(input, 1)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case RFC3339():
+		t, err := time.Parse(time.RFC3339, input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t.In(c.Location), nil
+
+	case RFC3339Nano():
+		t, err := time.Parse(time.RFC3339Nano, input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t.In(c.Location), nil
+
+	case DateTime():
+		t, err := time.ParseInLocation("2006-01-02 15:04:05", input, c.Location)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case DateOnly():
+		t, err := time.ParseInLocation("2006-01-02", input, c.Location)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case TimeOnly():
+		today := c.now().Format("2006-01-02")
+		fullTime := today + " " + input
+		t, err := time.ParseInLocation("2006-01-02 15:04:05", fullTime, c.Location)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case NaturalLanguage():
+		t, err := c.ParseNatural(input, c.now())
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case FileTime(), LDAP():
+		t, err := c.parseTicks(input, fileTimeEpochOffset)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case DotNetTicks():
+		t, err := c.parseTicks(input, dotNetEpochOffset)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case Cocoa():
+		t, err := c.parseCocoa(input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case HFSPlus():
+		t, err := c.parseHFSPlus(input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case WebKit():
+		t, err := c.parseWebKit(input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case GPS():
+		t, err := c.parseGPS(input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case JulianDay():
+		t, err := c.parseJulianDay(input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case ModifiedJulianDay():
+		t, err := c.parseModifiedJulianDay(input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case ExcelSerial():
+		t, err := c.parseExcelSerial(input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case ExcelSerial1904():
+		t, err := c.parseExcelSerial1904(input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case RFC1123(), RFC822():
+		t, err := c.parseMailDate(input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case ANSIC():
+		t, err := c.parseTextDate(input, []string{time.ANSIC})
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case UnixDate():
+		t, err := c.parseTextDate(input, []string{time.UnixDate, time.RubyDate})
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case GoString():
+		t, err := c.parseGoString(input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case ISO8601():
+		t, err := c.parseISO8601(input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case Syslog():
+		t, err := c.parseSyslog(input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case CLF():
+		t, err := c.parseCLF(input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case LogDateTime():
+		t, err := time.ParseInLocation("2006/01/02 15:04:05", input, c.Location)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case Klog():
+		t, err := c.parseKlog(input)
+		if err != nil {
+			return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+		}
+		return t, nil
+
+	case Snowflake(), ULID(), UUID(), KSUID(), ObjectID():
+		info, err := c.DecodeID(input, format)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return info.Time, nil
+
+	default:
+		if format.IsCustom() {
+			t, err := c.parseCustom(input, format)
+			if err != nil {
+				return time.Time{}, &ParseError{Input: input, Format: format, Err: err}
+			}
+			return t, nil
+		}
+		return time.Time{}, &ParseError{Input: input, Format: format, Err: ErrUnsupportedFormat}
+	}
+}
+
+// ConvertResult 轉換結果
+// DetectedFormat、Weekday、Localized 與 Relative 的文字皆為英文，其他語言由呼叫端依 Format 與 Time 翻譯
+type ConvertResult struct {
+	Original          string       `json:"original"`
+	DetectedFormat    string       `json:"detected_format"`
+	UnixSeconds       int64        `json:"unix_seconds"`
+	UnixMillis        int64        `json:"unix_milliseconds"`
+	UnixMicros        int64        `json:"unix_microseconds"`
+	UnixNanos         int64        `json:"unix_nanoseconds"`
+	FileTime          int64        `json:"filetime"`
+	DotNetTicks       int64        `json:"dotnet_ticks"`
+	Cocoa             float64      `json:"cocoa"`
+	HFSPlus           int64        `json:"hfs_plus"`
+	WebKit            int64        `json:"webkit"`
+	GPS               GPSTime      `json:"gps"`
+	JulianDay         float64      `json:"julian_day"`
+	ModifiedJulianDay float64      `json:"modified_julian_day"`
+	ExcelSerial       float64      `json:"excel_serial"`
+	ExcelSerial1904   float64      `json:"excel_serial_1904"`
+	RFC3339           string       `json:"rfc3339"`
+	RFC3339Nano       string       `json:"rfc3339_nano"`
+	RFC1123           string       `json:"rfc1123"`
+	HTTPDate          string       `json:"http_date"`
+	RFC822            string       `json:"rfc822"`
+	RFC2822           string       `json:"rfc2822"`
+	ANSIC             string       `json:"ansic"`
+	UnixDate          string       `json:"unix_date"`
+	GoString          string       `json:"go_string"`
+	ISO8601Basic      string       `json:"iso8601_basic"`
+	ISOWeek           string       `json:"iso_week"`
+	Ordinal           string       `json:"ordinal"`
+	DateTime          string       `json:"datetime"`
+	DateOnly          string       `json:"date_only"`
+	TimeOnly          string       `json:"time_only"`
+	Weekday           string       `json:"weekday"`
+	Localized         string       `json:"localized"`
+	Timezone          string       `json:"timezone"`
+	Relative          RelativeTime `json:"relative"`
+	Zones             []ZoneTime   `json:"zones,omitempty"`
+	ID                *IDInfo      `json:"id,omitempty"`
+	Candidates        []Candidate  `json:"candidates,omitempty"`
+
+	// Time 解析後的時間，供自訂輸出格式使用
+	Time time.Time `json:"-"`
+
+	// Format 解析時採用的格式，供呼叫端以其他語言顯示格式名稱
+	Format TimestampFormat `json:"-"`
+}
+
+// Convert 轉換時間到所有格式
+func (c *Converter) Convert(input string, inputFormat *TimestampFormat) (*ConvertResult, error) {
+	var format TimestampFormat
+	var err error
+
+	if inputFormat != nil {
+		format = *inputFormat
+	} else {
+		format, err = c.DetectFormat(input)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t, err := c.Parse(input, format)
+	if err != nil {
+		return nil, err
+	}
+
+	result := c.newResult(input, format, t)
+
+	// 自動偵測的數字時間戳列出所有合理解讀
+	if inputFormat == nil {
+		result.Candidates = c.DetectCandidates(input)
+	}
+
+	// ID 格式額外回報 ID 中的欄位
+	if isIDFormat(format) {
+		result.ID, _ = c.DecodeID(input, format)
+	}
+
+	return result, nil
+}
+
+// ConvertTime 將已知的時間轉換到所有格式，不經過格式偵測與解析，保留納秒精確度
+// Original 為 RFC 3339 (含納秒) 表示法，Format 為 RFC3339Nano
+func (c *Converter) ConvertTime(t time.Time) *ConvertResult {
+	t = t.In(c.Location)
+	return c.newResult(t.Format(time.RFC3339Nano), RFC3339Nano(), t)
+}
+
+// newResult 以解析後的時間填入所有輸出格式
+func (c *Converter) newResult(input string, format TimestampFormat, t time.Time) *ConvertResult {
+	return &ConvertResult{
+		Original:          input,
+		DetectedFormat:    c.formatName(format),
+		UnixSeconds:       t.Unix(),
+		UnixMillis:        t.UnixMilli(),
+		UnixMicros:        t.UnixMicro(),
+		UnixNanos:         t.UnixNano(),
+		FileTime:          ToFileTime(t),
+		DotNetTicks:       ToDotNetTicks(t),
+		Cocoa:             ToCocoa(t),
+		HFSPlus:           ToHFSPlus(t),
+		WebKit:            ToWebKit(t),
+		GPS:               ToGPS(t),
+		JulianDay:         ToJulianDay(t),
+		ModifiedJulianDay: ToModifiedJulianDay(t),
+		ExcelSerial:       ToExcelSerial(t),
+		ExcelSerial1904:   ToExcelSerial1904(t),
+		RFC3339:           t.Format(time.RFC3339),
+		RFC3339Nano:       t.Format(time.RFC3339Nano),
+		RFC1123:           t.Format(time.RFC1123),
+		HTTPDate:          t.UTC().Format(httpDateLayout),
+		RFC822:            t.Format(time.RFC822),
+		RFC2822:           t.Format(time.RFC1123Z),
+		ANSIC:             t.Format(time.ANSIC),
+		UnixDate:          t.Format(time.UnixDate),
+		GoString:          t.Round(0).String(),
+		ISO8601Basic:      FormatISO8601Basic(t),
+		ISOWeek:           FormatISOWeek(t),
+		Ordinal:           FormatOrdinal(t),
+		DateTime:          t.Format("2006-01-02 15:04:05"),
+		DateOnly:          t.Format("2006-01-02"),
+		TimeOnly:          t.Format("15:04:05"),
+		Weekday:           t.Weekday().String(),
+		Localized:         c.longDateTime(t),
+		Timezone:          c.getTimezoneInfo(t),
+		Relative:          c.RelativeTo(t, c.now()),
+		Zones:             c.InZones(t, c.Zones),
+		Time:              t,
+		Format:            format,
+	}
+}
+
+// formatName 返回格式名稱
+func (c *Converter) formatName(format TimestampFormat) string {
+	return format.String()
+}
+
+// String 返回格式的英文名稱，自訂格式會附上原始樣式；其他語言的名稱由呼叫端翻譯
+func (format TimestampFormat) String() string {
+	switch format {
+	case UnixSeconds():
+		return "Unix timestamp (seconds)"
+	case UnixMilliseconds():
+		return "Unix timestamp (milliseconds)"
+	case UnixMicroseconds():
+		return "Unix timestamp (microseconds)"
+	case UnixNanoseconds():
+		return "Unix timestamp (nanoseconds)"
+	case RFC3339():
+		return "RFC3339"
+	case RFC3339Nano():
+		return "RFC3339Nano"
+	case DateTime():
+		return "Date and time"
+	case DateOnly():
+		return "Date"
+	case TimeOnly():
+		return "Time of day"
+	case NaturalLanguage():
+		return "Natural-language relative time"
+	case FileTime():
+		return "Windows FILETIME"
+	case DotNetTicks():
+		return ".NET DateTime Ticks"
+	case LDAP():
+		return "LDAP/Active Directory timestamp"
+	case Cocoa():
+		return "Apple Cocoa/Core Data timestamp"
+	case HFSPlus():
+		return "HFS+ timestamp"
+	case WebKit():
+		return "WebKit/Chrome timestamp"
+	case GPS():
+		return "GPS time"
+	case JulianDay():
+		return "Julian Day (JD)"
+	case ModifiedJulianDay():
+		return "Modified Julian Day (MJD)"
+	case ExcelSerial():
+		return "Excel serial (1900 date system)"
+	case ExcelSerial1904():
+		return "Excel serial (1904 date system)"
+	case Snowflake():
+		return "Snowflake ID"
+	case ULID():
+		return "ULID"
+	case UUID():
+		return "UUID"
+	case KSUID():
+		return "KSUID"
+	case ObjectID():
+		return "MongoDB ObjectID"
+	case RFC1123():
+		return "RFC 1123/HTTP date"
+	case RFC822():
+		return "RFC 822/2822 email date"
+	case ANSIC():
+		return "ANSI C asctime"
+	case UnixDate():
+		return "Unix date command"
+	case GoString():
+		return "Go time.String()"
+	case ISO8601():
+		return "ISO 8601"
+	case Syslog():
+		return "Syslog (RFC 3164) time"
+	case CLF():
+		return "Apache/Nginx Common Log Format (CLF)"
+	case LogDateTime():
+		return "Log date time (Nginx error log, Go log)"
+	case Klog():
+		return "Kubernetes klog time"
+	default:
+		if format.kind == "strftime" {
+			return fmt.Sprintf("strftime pattern (%s)", format.pattern)
+		}
+		if format.IsCustom() {
+			return fmt.Sprintf("Custom Go layout (%s)", format.pattern)
+		}
+		return "Unknown format"
+	}
+}
+
+// longDateTime 以英文完整日期與時間表示 (如 "Friday, January 21, 2022 at 16:07:14")
+// 其他語言由呼叫端依 ConvertResult.Time 自行組成
+func (c *Converter) longDateTime(t time.Time) string {
+	return fmt.Sprintf("%s, %s %d, %d at %s", t.Weekday(), t.Month(), t.Day(), t.Year(), t.Format("15:04:05"))
+}
+
+// getTimezoneInfo 取得時區資訊
+func (c *Converter) getTimezoneInfo(t time.Time) string {
+	zone, offset := t.Zone()
+	location := t.Location().String()
+	offsetStr := formatOffset(offset)
+
+	if location == "Local" {
+		if zone == "" {
+			return fmt.Sprintf("Local (UTC%s)", offsetStr)
+		}
+		return fmt.Sprintf("Local (%s, UTC%s)", zone, offsetStr)
+	}
+
+	if zone == "" {
+		return fmt.Sprintf("%s (UTC%s)", location, offsetStr)
+	}
+
+	return fmt.Sprintf("%s (%s, UTC%s)", location, zone, offsetStr)
+}
+
+// formatOffset 將時區偏移秒數格式化為 +hh:mm
+func formatOffset(offset int) string {
+	// 計算時區偏移量
+	offsetHours := offset / 3600
+	offsetMinutes := (offset % 3600) / 60
+
+	if offset >= 0 {
+		return fmt.Sprintf("+%02d:%02d", offsetHours, offsetMinutes)
+	}
+	return fmt.Sprintf("-%02d:%02d", -offsetHours, -offsetMinutes)
+}
@@ -43,17 +43,17 @@ func TestDetectFormat(t *testing.T) {
 		wantFormat TimestampFormat
 		wantErr    bool
 	}{
-		{"unix seconds 10 digits", "1642781234", UnixSeconds, false},
-		{"unix milliseconds 13 digits", "1642781234000", UnixMilliseconds, false},
-		{"unix microseconds 16 digits", "1642781234000000", UnixMicroseconds, false},
-		{"unix nanoseconds 19 digits", "1642781234000000000", UnixNanoseconds, false},
-		{"RFC3339 format", "2022-01-21T12:00:34Z", RFC3339, false},
-		{"RFC3339 with timezone", "2022-01-21T12:00:34+08:00", RFC3339, false},
-		{"RFC3339Nano format", "2022-01-21T12:00:34.123456789Z", RFC3339Nano, false},
-		{"DateTime format", "2022-01-21 12:00:34", DateTime, false},
-		{"DateOnly format", "2022-01-21", DateOnly, false},
-		{"TimeOnly format", "12:00:34", TimeOnly, false},
-		{"invalid format", "not-a-timestamp", UnixSeconds, true},
+		{"unix seconds 10 digits", "1642781234", UnixSeconds(), false},
+		{"unix milliseconds 13 digits", "1642781234000", UnixMilliseconds(), false},
+		{"unix microseconds 16 digits", "1642781234000000", UnixMicroseconds(), false},
+		{"unix nanoseconds 19 digits", "1642781234000000000", UnixNanoseconds(), false},
+		{"RFC3339 format", "2022-01-21T12:00:34Z", RFC3339(), false},
+		{"RFC3339 with timezone", "2022-01-21T12:00:34+08:00", RFC3339(), false},
+		{"RFC3339Nano format", "2022-01-21T12:00:34.123456789Z", RFC3339Nano(), false},
+		{"DateTime format", "2022-01-21 12:00:34", DateTime(), false},
+		{"DateOnly format", "2022-01-21", DateOnly(), false},
+		{"TimeOnly format", "12:00:34", TimeOnly(), false},
+		{"invalid format", "not-a-timestamp", UnixSeconds(), true},
 	}
 
 	for _, tt := range tests {
@@ -79,16 +79,16 @@ func TestParse(t *testing.T) {
 		format  TimestampFormat
 		wantErr bool
 	}{
-		{"parse unix seconds", "1642781234", UnixSeconds, false},
-		{"parse unix milliseconds", "1642781234000", UnixMilliseconds, false},
-		{"parse unix microseconds", "1642781234000000", UnixMicroseconds, false},
-		{"parse unix nanoseconds", "1642781234000000000", UnixNanoseconds, false},
-		{"parse RFC3339", "2022-01-21T12:00:34Z", RFC3339, false},
-		{"parse RFC3339Nano", "2022-01-21T12:00:34.123456789Z", RFC3339Nano, false},
-		{"parse DateTime", "2022-01-21 12:00:34", DateTime, false},
-		{"parse DateOnly", "2022-01-21", DateOnly, false},
-		{"parse TimeOnly", "12:00:34", TimeOnly, false},
-		{"invalid unix", "not-a-number", UnixSeconds, true},
+		{"parse unix seconds", "1642781234", UnixSeconds(), false},
+		{"parse unix milliseconds", "1642781234000", UnixMilliseconds(), false},
+		{"parse unix microseconds", "1642781234000000", UnixMicroseconds(), false},
+		{"parse unix nanoseconds", "1642781234000000000", UnixNanoseconds(), false},
+		{"parse RFC3339", "2022-01-21T12:00:34Z", RFC3339(), false},
+		{"parse RFC3339Nano", "2022-01-21T12:00:34.123456789Z", RFC3339Nano(), false},
+		{"parse DateTime", "2022-01-21 12:00:34", DateTime(), false},
+		{"parse DateOnly", "2022-01-21", DateOnly(), false},
+		{"parse TimeOnly", "12:00:34", TimeOnly(), false},
+		{"invalid unix", "not-a-number", UnixSeconds(), true},
 	}
 
 	for _, tt := range tests {
@@ -105,7 +105,7 @@ func TestParseUnixSeconds(t *testing.T) {
 	conv, _ := NewConverter("UTC")
 
 	// Test specific timestamp: 2022-01-21 16:07:14 UTC
-	result, err := conv.Parse("1642781234", UnixSeconds)
+	result, err := conv.Parse("1642781234", UnixSeconds())
 	if err != nil {
 		t.Fatalf("Parse failed: %v", err)
 	}
@@ -322,3 +322,22 @@ func BenchmarkConvert(b *testing.B) {
 		conv.Convert("1642781234", nil)
 	}
 }
+
+func TestConvertTime(t *testing.T) {
+	conv, _ := NewConverter("Asia/Taipei")
+	conv.Now = func() time.Time { return time.Date(2022, 1, 24, 16, 7, 14, 0, time.UTC) }
+
+	// 範圍外的時間與次秒精確度都不受數字偵測影響
+	conv.Strict = true
+	in := time.Date(1900, 1, 1, 0, 0, 0, 123456789, time.UTC)
+	result := conv.ConvertTime(in)
+	if result.Original != "1900-01-01T08:00:00.123456789+08:00" || result.Format != RFC3339Nano() {
+		t.Errorf("Original, Format = %q, %v", result.Original, result.Format)
+	}
+	if !result.Time.Equal(in) || result.UnixNanos != in.UnixNano() {
+		t.Errorf("Time = %v, UnixNanos = %d", result.Time, result.UnixNanos)
+	}
+	if result.Candidates != nil {
+		t.Errorf("Candidates = %+v, want nil", result.Candidates)
+	}
+}
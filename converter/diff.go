@@ -0,0 +1,239 @@
+package converter
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CalendarDuration 依日曆拆解的時間間隔
+type CalendarDuration struct {
+	Years       int `json:"years"`
+	Months      int `json:"months"`
+	Days        int `json:"days"`
+	Hours       int `json:"hours"`
+	Minutes     int `json:"minutes"`
+	Seconds     int `json:"seconds"`
+	Nanoseconds int `json:"nanoseconds"`
+}
+
+// DiffResult 兩個時間點之間的間隔
+// Humanized 為英文描述，其他語言由呼叫端依 Calendar.Parts 翻譯
+type DiffResult struct {
+	From         string           `json:"from"`
+	To           string           `json:"to"`
+	FromRFC3339  string           `json:"from_rfc3339"`
+	ToRFC3339    string           `json:"to_rfc3339"`
+	Negative     bool             `json:"negative"`
+	TotalSeconds float64          `json:"total_seconds"`
+	TotalMillis  *int64           `json:"total_milliseconds,omitempty"` // 超出 int64 範圍時為 nil
+	TotalNanos   *int64           `json:"total_nanoseconds,omitempty"`  // 超過約 292 年時為 nil
+	Calendar     CalendarDuration `json:"calendar"`
+	ISO8601      string           `json:"iso8601"`
+	Humanized    string           `json:"humanized"`
+	Timezone     string           `json:"timezone"`
+}
+
+// Diff 解析兩個輸入並計算 to - from 的間隔
+// inputFormat 為 nil 時兩者皆自動偵測格式
+func (c *Converter) Diff(from, to string, inputFormat *TimestampFormat) (*DiffResult, error) {
+	fromTime, err := c.parseInput(from, inputFormat)
+	if err != nil {
+		return nil, err
+	}
+	toTime, err := c.parseInput(to, inputFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	result := c.DiffTimes(fromTime, toTime)
+	result.From = strings.TrimSpace(from)
+	result.To = strings.TrimSpace(to)
+	return result, nil
+}
+
+// parseInput 依指定格式或自動偵測的格式解析輸入
+func (c *Converter) parseInput(input string, inputFormat *TimestampFormat) (time.Time, error) {
+	var format TimestampFormat
+	if inputFormat != nil {
+		format = *inputFormat
+	} else {
+		var err error
+		format, err = c.DetectFormat(input)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+	return c.Parse(input, format)
+}
+
+// DiffTimes 計算 to - from 的間隔，日曆拆解使用 Converter.Location
+func (c *Converter) DiffTimes(from, to time.Time) *DiffResult {
+	from = from.In(c.Location)
+	to = to.In(c.Location)
+
+	// 以秒與納秒分開計算，避免 time.Sub 在超過約 292 年時飽和
+	secs := to.Unix() - from.Unix()
+	nanos := int64(to.Nanosecond() - from.Nanosecond())
+
+	result := &DiffResult{
+		From:         from.Format(time.RFC3339Nano),
+		To:           to.Format(time.RFC3339Nano),
+		FromRFC3339:  from.Format(time.RFC3339Nano),
+		ToRFC3339:    to.Format(time.RFC3339Nano),
+		Negative:     to.Before(from),
+		TotalSeconds: float64(secs) + float64(nanos)/1e9,
+		TotalMillis:  scaledTotal(secs, nanos, time.Millisecond),
+		TotalNanos:   scaledTotal(secs, nanos, time.Nanosecond),
+		Timezone:     c.getTimezoneInfo(to),
+	}
+
+	start, end := from, to
+	if result.Negative {
+		start, end = to, from
+	}
+	result.Calendar = calendarDiff(start, end)
+	result.ISO8601 = result.Calendar.ISO8601(result.Negative)
+	result.Humanized = result.Calendar.Humanize()
+	return result
+}
+
+// scaledTotal 以 unit 為單位表示 secs 秒加 nanos 納秒的總量，不足一單位的部分捨去
+// 結果超出 int64 範圍時回傳 nil，而不是讓數值溢位
+func scaledTotal(secs, nanos int64, unit time.Duration) *int64 {
+	total := new(big.Int).Mul(big.NewInt(secs), big.NewInt(int64(time.Second/unit)))
+	total.Add(total, big.NewInt(nanos/int64(unit)))
+	if !total.IsInt64() {
+		return nil
+	}
+	v := total.Int64()
+	return &v
+}
+
+// calendarDiff 計算 start 到 end (start <= end) 的日曆間隔
+// 先取完整月數 (月底日期會對齊，如 1/31 加一個月為 2/29)，再取剩餘的天數與時分秒
+func calendarDiff(start, end time.Time) CalendarDuration {
+	y1, mo1, _ := start.Date()
+	y2, mo2, _ := end.Date()
+
+	months := (y2-y1)*12 + int(mo2-mo1)
+	anchor := shiftPeriodClamped(start, "M", months)
+	for months > 0 && anchor.After(end) {
+		months--
+		anchor = shiftPeriodClamped(start, "M", months)
+	}
+
+	days := civilDays(end) - civilDays(anchor)
+	dayAnchor := anchor.AddDate(0, 0, days)
+	for days > 0 && dayAnchor.After(end) {
+		days--
+		dayAnchor = anchor.AddDate(0, 0, days)
+	}
+
+	rest := end.Sub(dayAnchor)
+	return CalendarDuration{
+		Years:       months / 12,
+		Months:      months % 12,
+		Days:        days,
+		Hours:       int(rest / time.Hour),
+		Minutes:     int(rest % time.Hour / time.Minute),
+		Seconds:     int(rest % time.Minute / time.Second),
+		Nanoseconds: int(rest % time.Second),
+	}
+}
+
+// civilDays 回傳牆上日期距離 1970-01-01 的天數
+func civilDays(t time.Time) int {
+	year, month, day := t.Date()
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// ISO8601 以 ISO 8601 期間表示，如 P1Y2M3DT4H5M6.5S
+func (d CalendarDuration) ISO8601(negative bool) string {
+	var sb strings.Builder
+	if negative {
+		sb.WriteByte('-')
+	}
+	sb.WriteByte('P')
+	if d.Years != 0 {
+		fmt.Fprintf(&sb, "%dY", d.Years)
+	}
+	if d.Months != 0 {
+		fmt.Fprintf(&sb, "%dM", d.Months)
+	}
+	if d.Days != 0 {
+		fmt.Fprintf(&sb, "%dD", d.Days)
+	}
+
+	if d.Hours != 0 || d.Minutes != 0 || d.Seconds != 0 || d.Nanoseconds != 0 {
+		sb.WriteByte('T')
+		if d.Hours != 0 {
+			fmt.Fprintf(&sb, "%dH", d.Hours)
+		}
+		if d.Minutes != 0 {
+			fmt.Fprintf(&sb, "%dM", d.Minutes)
+		}
+		if d.Seconds != 0 || d.Nanoseconds != 0 {
+			sb.WriteString(strconv.Itoa(d.Seconds))
+			if d.Nanoseconds != 0 {
+				frac := strings.TrimRight(fmt.Sprintf("%09d", d.Nanoseconds), "0")
+				sb.WriteString("." + frac)
+			}
+			sb.WriteByte('S')
+		}
+	}
+
+	if sb.Len() == 1 || (negative && sb.Len() == 2) {
+		sb.WriteString("T0S")
+	}
+	return sb.String()
+}
+
+// DurationPart 間隔中單一非零的單位，Unit 為 year、month、day、hour、minute 或 second
+type DurationPart struct {
+	Value int    `json:"value"`
+	Unit  string `json:"unit"`
+}
+
+// Parts 由大到小列出非零的單位 (不含納秒)，呼叫端可依此以任何語言描述間隔
+func (d CalendarDuration) Parts() []DurationPart {
+	all := []DurationPart{
+		{d.Years, "year"},
+		{d.Months, "month"},
+		{d.Days, "day"},
+		{d.Hours, "hour"},
+		{d.Minutes, "minute"},
+		{d.Seconds, "second"},
+	}
+
+	var parts []DurationPart
+	for _, p := range all {
+		if p.Value != 0 {
+			parts = append(parts, p)
+		}
+	}
+	return parts
+}
+
+// Humanize 以英文描述間隔，如 "1 year, 2 months, 3 days"；其他語言由呼叫端依 Parts 翻譯
+func (d CalendarDuration) Humanize() string {
+	parts := d.Parts()
+	if len(parts) == 0 {
+		if d.Nanoseconds != 0 {
+			return "less than a second"
+		}
+		return "0 seconds"
+	}
+
+	words := make([]string, len(parts))
+	for i, p := range parts {
+		unit := p.Unit
+		if p.Value != 1 {
+			unit += "s"
+		}
+		words[i] = fmt.Sprintf("%d %s", p.Value, unit)
+	}
+	return strings.Join(words, ", ")
+}
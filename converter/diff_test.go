@@ -0,0 +1,162 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		name         string
+		from         string
+		to           string
+		wantSeconds  float64
+		wantMillis   int64
+		wantCalendar CalendarDuration
+		wantISO      string
+		wantHuman    string
+		wantNegative bool
+	}{
+		{
+			"mixed formats",
+			"1642781234", "2022-01-21T17:08:15.5Z",
+			3661.5, 3661500,
+			CalendarDuration{Hours: 1, Minutes: 1, Seconds: 1, Nanoseconds: 500000000},
+			"PT1H1M1.5S", "1 hour, 1 minute, 1 second", false,
+		},
+		{
+			"calendar months borrow days",
+			"2024-01-31 00:00:00", "2024-03-01 00:00:00",
+			30 * 86400, 30 * 86400000,
+			CalendarDuration{Months: 1, Days: 1},
+			"P1M1D", "1 month, 1 day", false,
+		},
+		{
+			"years",
+			"2020-02-29", "2024-02-28",
+			1460 * 86400, 1460 * 86400000,
+			CalendarDuration{Years: 3, Months: 11, Days: 30},
+			"P3Y11M30D", "3 years, 11 months, 30 days", false,
+		},
+		{
+			"negative interval",
+			"1642781294000", "1642781234",
+			-60, -60000,
+			CalendarDuration{Minutes: 1},
+			"-PT1M", "1 minute", true,
+		},
+		{
+			"zero interval",
+			"1642781234", "1642781234000",
+			0, 0,
+			CalendarDuration{},
+			"PT0S", "0 seconds", false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := conv.Diff(tt.from, tt.to, nil)
+			if err != nil {
+				t.Fatalf("Diff(%q, %q) error = %v", tt.from, tt.to, err)
+			}
+			if result.TotalSeconds != tt.wantSeconds {
+				t.Errorf("TotalSeconds = %v, want %v", result.TotalSeconds, tt.wantSeconds)
+			}
+			if result.TotalMillis == nil || *result.TotalMillis != tt.wantMillis {
+				t.Errorf("TotalMillis = %v, want %v", result.TotalMillis, tt.wantMillis)
+			}
+			if result.TotalNanos == nil || *result.TotalNanos != tt.wantMillis*1e6 {
+				t.Errorf("TotalNanos = %v, want %v", result.TotalNanos, tt.wantMillis*1e6)
+			}
+			if result.Calendar != tt.wantCalendar {
+				t.Errorf("Calendar = %+v, want %+v", result.Calendar, tt.wantCalendar)
+			}
+			if result.ISO8601 != tt.wantISO {
+				t.Errorf("ISO8601 = %q, want %q", result.ISO8601, tt.wantISO)
+			}
+			if result.Humanized != tt.wantHuman {
+				t.Errorf("Humanized = %q, want %q", result.Humanized, tt.wantHuman)
+			}
+			if result.Negative != tt.wantNegative {
+				t.Errorf("Negative = %v, want %v", result.Negative, tt.wantNegative)
+			}
+			if result.From != tt.from || result.To != tt.to {
+				t.Errorf("From/To = %q/%q, want %q/%q", result.From, result.To, tt.from, tt.to)
+			}
+		})
+	}
+
+	if _, err := conv.Diff("invalid", "1642781234", nil); err == nil {
+		t.Error("Diff with invalid input expected error")
+	}
+}
+
+func TestCalendarDurationParts(t *testing.T) {
+	d := CalendarDuration{Years: 1, Days: 3, Minutes: 5, Nanoseconds: 7}
+	want := []DurationPart{{1, "year"}, {3, "day"}, {5, "minute"}}
+	if got := d.Parts(); !slices.Equal(got, want) {
+		t.Errorf("Parts() = %v, want %v", got, want)
+	}
+	if got := (CalendarDuration{Nanoseconds: 7}).Parts(); got != nil {
+		t.Errorf("Parts() of sub-second duration = %v, want nil", got)
+	}
+}
+
+func TestDiffTimesLongSpan(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	// 800 年的納秒數超出 int64，毫秒數仍可表示
+	from := time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2400, 1, 1, 0, 0, 0, 500_000_000, time.UTC)
+	const secs = 25245561600 // 兩個 400 年週期共 292194 天
+
+	result := conv.DiffTimes(from, to)
+	if result.TotalSeconds != secs+0.5 {
+		t.Errorf("TotalSeconds = %v, want %v", result.TotalSeconds, secs+0.5)
+	}
+	if result.TotalMillis == nil || *result.TotalMillis != secs*1000+500 {
+		t.Errorf("TotalMillis = %v, want %v", result.TotalMillis, int64(secs*1000+500))
+	}
+	if result.TotalNanos != nil {
+		t.Errorf("TotalNanos = %v, want nil", *result.TotalNanos)
+	}
+	if want := (CalendarDuration{Years: 800, Nanoseconds: 500_000_000}); result.Calendar != want {
+		t.Errorf("Calendar = %+v, want %+v", result.Calendar, want)
+	}
+
+	reverse := conv.DiffTimes(to, from)
+	if reverse.TotalMillis == nil || *reverse.TotalMillis != -(secs*1000+500) {
+		t.Errorf("reverse TotalMillis = %v, want %v", reverse.TotalMillis, int64(-(secs*1000 + 500)))
+	}
+	if reverse.TotalNanos != nil {
+		t.Errorf("reverse TotalNanos = %v, want nil", *reverse.TotalNanos)
+	}
+}
+
+func TestDiffTimesCalendarTimezone(t *testing.T) {
+	// 同一組時間點在不同時區的日曆拆解可能不同
+	from := time.Date(2024, 2, 29, 20, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 31, 20, 0, 0, 0, time.UTC)
+
+	convUTC, _ := NewConverter("UTC")
+	convTaipei, _ := NewConverter("Asia/Taipei")
+
+	utc := convUTC.DiffTimes(from, to)
+	taipei := convTaipei.DiffTimes(from, to)
+
+	if utc.TotalSeconds != taipei.TotalSeconds {
+		t.Errorf("TotalSeconds differ: %v vs %v", utc.TotalSeconds, taipei.TotalSeconds)
+	}
+	// UTC: 2/29 -> 3/31 為一個月又兩天; 台北: 3/1 04:00 -> 4/1 04:00 剛好一個月
+	if want := (CalendarDuration{Months: 1, Days: 2}); utc.Calendar != want {
+		t.Errorf("UTC Calendar = %+v, want %+v", utc.Calendar, want)
+	}
+	if want := (CalendarDuration{Months: 1}); taipei.Calendar != want {
+		t.Errorf("Taipei Calendar = %+v, want %+v", taipei.Calendar, want)
+	}
+}
@@ -0,0 +1,27 @@
+// Package converter 提供時間戳的格式偵測、解析與轉換，與 timestamp 命令列工具使用相同的邏輯。
+//
+// 基本用法是以時區建立 Converter，再呼叫 Convert 取得所有輸出格式：
+//
+//	conv, err := converter.NewConverter("Asia/Taipei")
+//	if err != nil {
+//		return err
+//	}
+//	result, err := conv.Convert("1642781234", nil)
+//
+// 傳入 nil 作為輸入格式時會以 DetectFormat 自動偵測；也可以指定 TimestampFormat
+// 常數，或以 LayoutFormat、StrftimeFormat 建立自訂格式。只需要 time.Time 時可直接
+// 使用 DetectFormat 與 Parse。
+//
+// 錯誤皆為具型別的值，可用 errors.Is 與 errors.As 判斷：
+//
+//   - 無法偵測格式時回傳 *FormatError (errors.Is(err, ErrUnknownFormat))
+//   - 無法以指定格式解析時回傳 *ParseError，Err 為底層的錯誤
+//   - 輸入中的值無效時回傳 *InputError，通常包裝於 *ParseError 之內；Kind 為
+//     ErrInvalidDate、ErrUnknownZone 等哨兵錯誤，Position 為該值在 ParseError.Input 中的位置
+//   - 時間偏移無法解析時回傳 *OffsetError (errors.Is(err, ErrInvalidOffset))
+//   - 時區無法載入時回傳 *TimezoneError (errors.Is(err, ErrInvalidTimezone))
+//
+// Converter 的欄位在建立後可直接調整，例如以 Now 固定參考時間，讓相對時間與
+// 僅含時間的輸入得到可重現的結果。Converter 本身不持有可變狀態，設定完成後可在
+// 多個 goroutine 間共用。
+package converter
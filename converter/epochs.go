@@ -0,0 +1,174 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// cocoaEpoch 2001-01-01 UTC (Apple Cocoa / Core Data 紀元) 的 Unix 秒數
+	cocoaEpoch = 978307200
+
+	// hfsEpoch 1904-01-01 UTC (HFS+ 紀元) 的 Unix 秒數
+	hfsEpoch = -2082844800
+
+	// gpsEpoch 1980-01-06 UTC (GPS 紀元) 的 Unix 秒數
+	gpsEpoch = 315964800
+
+	// secondsPerWeek 一週的秒數，GPS 時間以週數與週內秒數表示
+	secondsPerWeek = 7 * 24 * 3600
+)
+
+// leapSeconds GPS 紀元後 UTC 插入閏秒的時刻 (Unix 秒)
+// 每筆都讓 GPS 時間比 UTC 多快一秒，自 2017-01-01 起差距為 18 秒
+var leapSeconds = []int64{
+	362793600,  // 1981-07-01
+	394329600,  // 1982-07-01
+	425865600,  // 1983-07-01
+	489024000,  // 1985-07-01
+	567993600,  // 1988-01-01
+	631152000,  // 1990-01-01
+	662688000,  // 1991-01-01
+	709948800,  // 1992-07-01
+	741484800,  // 1993-07-01
+	773020800,  // 1994-07-01
+	820454400,  // 1996-01-01
+	867715200,  // 1997-07-01
+	915148800,  // 1999-01-01
+	1136073600, // 2006-01-01
+	1230768000, // 2009-01-01
+	1341100800, // 2012-07-01
+	1435708800, // 2015-07-01
+	1483228800, // 2017-01-01
+}
+
+// GPSTime GPS 時間，以週數與週內秒數表示
+type GPSTime struct {
+	Week          int     `json:"week"`
+	SecondsOfWeek float64 `json:"seconds_of_week"`
+	Seconds       float64 `json:"seconds"`
+	LeapSeconds   int     `json:"leap_seconds"`
+}
+
+// String 以 "週數:週內秒數" 表示 GPS 時間，與 -i gps 接受的格式相同
+func (g GPSTime) String() string {
+	return fmt.Sprintf("%d:%s", g.Week, strconv.FormatFloat(g.SecondsOfWeek, 'f', -1, 64))
+}
+
+// ToCocoa 將時間轉為 Apple Cocoa / Core Data 時間戳 (自 2001-01-01 UTC 起的秒數)
+func ToCocoa(t time.Time) float64 {
+	return float64(t.Unix()-cocoaEpoch) + float64(t.Nanosecond())/1e9
+}
+
+// ToHFSPlus 將時間轉為 HFS+ 時間戳 (自 1904-01-01 UTC 起的秒數)
+func ToHFSPlus(t time.Time) int64 {
+	return t.Unix() - hfsEpoch
+}
+
+// ToWebKit 將時間轉為 WebKit / Chrome 時間戳 (自 1601-01-01 UTC 起的微秒數)
+func ToWebKit(t time.Time) int64 {
+	return (t.Unix()+fileTimeEpochOffset)*1e6 + int64(t.Nanosecond()/1e3)
+}
+
+// ToGPS 將時間轉為 GPS 時間，已計入 GPS 紀元後的閏秒
+func ToGPS(t time.Time) GPSTime {
+	leaps := leapSecondsAt(t.Unix())
+	total := t.Unix() - gpsEpoch + int64(leaps)
+	week := total / secondsPerWeek
+	if total%secondsPerWeek < 0 {
+		week--
+	}
+	fraction := float64(t.Nanosecond()) / 1e9
+	return GPSTime{
+		Week:          int(week),
+		SecondsOfWeek: float64(total-week*secondsPerWeek) + fraction,
+		Seconds:       float64(total) + fraction,
+		LeapSeconds:   leaps,
+	}
+}
+
+// leapSecondsAt 取得 Unix 時間當下 GPS 與 UTC 的閏秒差
+func leapSecondsAt(unix int64) int {
+	n := 0
+	for _, leap := range leapSeconds {
+		if unix >= leap {
+			n++
+		}
+	}
+	return n
+}
+
+// gpsToUnix 將 GPS 紀元起的秒數轉為 Unix 秒數，扣除當時的閏秒差
+func gpsToUnix(gps int64) int64 {
+	leaps := 0
+	for i, leap := range leapSeconds {
+		// 第 i 個閏秒生效時 GPS 時間已比 UTC 快 i+1 秒
+		if gps >= leap-gpsEpoch+int64(i+1) {
+			leaps = i + 1
+		}
+	}
+	return gps + gpsEpoch - int64(leaps)
+}
+
+// parseDecimalSeconds 將十進位秒數 (如 "-12.5") 拆為整數秒與奈秒，奈秒恆為非負
+func parseDecimalSeconds(input string) (int64, int64, error) {
+	return parseDecimal(input, int64(time.Second))
+}
+
+// parseCocoa 解析 Apple Cocoa / Core Data 時間戳，接受小數秒
+func (c *Converter) parseCocoa(input string) (time.Time, error) {
+	secs, nanos, err := parseDecimalSeconds(input)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(secs+cocoaEpoch, nanos).In(c.Location), nil
+}
+
+// parseHFSPlus 解析 HFS+ 時間戳
+func (c *Converter) parseHFSPlus(input string) (time.Time, error) {
+	secs, err := strconv.ParseInt(input, 10, 64)
+	if err != nil {
+		return time.Time{}, inputError(ErrInvalidNumber, input)
+	}
+	return time.Unix(secs+hfsEpoch, 0).In(c.Location), nil
+}
+
+// parseWebKit 解析 WebKit / Chrome 時間戳
+func (c *Converter) parseWebKit(input string) (time.Time, error) {
+	micros, err := strconv.ParseInt(input, 10, 64)
+	if err != nil {
+		return time.Time{}, inputError(ErrInvalidNumber, input)
+	}
+	secs, rem := micros/1e6, micros%1e6
+	if rem < 0 {
+		secs--
+		rem += 1e6
+	}
+	return time.Unix(secs-fileTimeEpochOffset, rem*1e3).In(c.Location), nil
+}
+
+// parseGPS 解析 GPS 時間，接受 "週數:週內秒數" 或自 GPS 紀元起的總秒數
+func (c *Converter) parseGPS(input string) (time.Time, error) {
+	weekPart, secsPart, hasWeek := strings.Cut(input, ":")
+	if !hasWeek {
+		secsPart = input
+	}
+
+	secs, nanos, err := parseDecimalSeconds(strings.TrimSpace(secsPart))
+	if err != nil {
+		return time.Time{}, err
+	}
+	if hasWeek {
+		week, err := strconv.ParseInt(strings.TrimSpace(weekPart), 10, 64)
+		if err != nil || week < 0 {
+			return time.Time{}, &InputError{Kind: ErrInvalidNumber, Type: "GPS", Value: weekPart, Position: 0}
+		}
+		if secs < 0 || secs >= secondsPerWeek {
+			return time.Time{}, &InputError{Kind: ErrOutOfRange, Type: "GPS", Value: secsPart, Position: len(input) - len(secsPart)}
+		}
+		secs += week * secondsPerWeek
+	}
+	return time.Unix(gpsToUnix(secs), nanos).In(c.Location), nil
+}
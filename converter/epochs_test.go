@@ -0,0 +1,134 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseEpochFormats(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+	want := time.Unix(1642781234, 0)
+
+	tests := []struct {
+		name   string
+		input  string
+		format TimestampFormat
+		want   time.Time
+	}{
+		{"cocoa", "664474034", Cocoa(), want},
+		{"cocoa fractional", "664474034.25", Cocoa(), want.Add(250 * time.Millisecond)},
+		{"cocoa before 2001", "-31536000.5", Cocoa(), time.Date(2000, 1, 1, 23, 59, 59, 500000000, time.UTC)},
+		{"hfs+", "3725626034", HFSPlus(), want},
+		{"hfs+ epoch", "0", HFSPlus(), time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"webkit", "13287254834000000", WebKit(), want},
+		{"webkit micros", "13287254834123456", WebKit(), want.Add(123456 * time.Microsecond)},
+		{"gps week:seconds", "2193:490052", GPS(), want},
+		{"gps total seconds", "1326816452", GPS(), want},
+		{"gps fractional", "2193:490052.5", GPS(), want.Add(500 * time.Millisecond)},
+		{"gps epoch", "0:0", GPS(), time.Date(1980, 1, 6, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := conv.Parse(tt.input, tt.format)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseEpochFormatsInvalid(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		input  string
+		format TimestampFormat
+	}{
+		{"abc", Cocoa()},
+		{"1.5", HFSPlus()},
+		{"1.2.3", Cocoa()},
+		{"2193:604800", GPS()},
+		{"-1:100", GPS()},
+		{"x:100", GPS()},
+	}
+
+	for _, tt := range tests {
+		if _, err := conv.Parse(tt.input, tt.format); err == nil {
+			t.Errorf("Parse(%q, %v) expected error", tt.input, tt.format)
+		}
+	}
+}
+
+func TestToGPSLeapSeconds(t *testing.T) {
+	tests := []struct {
+		name  string
+		t     time.Time
+		week  int
+		sow   float64
+		leaps int
+	}{
+		{"epoch", time.Date(1980, 1, 6, 0, 0, 0, 0, time.UTC), 0, 0, 0},
+		{"before 2017 leap", time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC), 1930, 16, 17},
+		{"after 2017 leap", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), 1930, 18, 18},
+		{"2022", time.Unix(1642781234, 0), 2193, 490052, 18},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := ToGPS(tt.t)
+			if g.Week != tt.week || g.SecondsOfWeek != tt.sow || g.LeapSeconds != tt.leaps {
+				t.Errorf("ToGPS(%v) = %+v, want week %d sow %v leaps %d", tt.t, g, tt.week, tt.sow, tt.leaps)
+			}
+		})
+	}
+}
+
+func TestGPSRoundTripAcrossLeapSeconds(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	for _, leap := range leapSeconds {
+		for delta := int64(-2); delta <= 2; delta++ {
+			want := time.Unix(leap+delta, 0)
+			got, err := conv.Parse(ToGPS(want).String(), GPS())
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", ToGPS(want).String(), err)
+			}
+			if !got.Equal(want) {
+				t.Errorf("GPS round trip of %v = %v", want.UTC(), got.UTC())
+			}
+		}
+	}
+}
+
+func TestDetectWebKit(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	format, err := conv.DetectFormat("13287254834000000")
+	if err != nil || format != WebKit() {
+		t.Errorf("DetectFormat(webkit) = %v, %v; want WebKit", format, err)
+	}
+	// 解讀為 WebKit 不合理 (9769 年) 時，採用 Unix 納秒 (1973 年)
+	if format, err := conv.DetectFormat("99999999999999999"); err != nil || format != UnixNanoseconds() {
+		t.Errorf("DetectFormat(implausible webkit) = %v, %v; want UnixNanoseconds", format, err)
+	}
+}
+
+func TestConvertEpochFields(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	result, err := conv.Convert("1642781234", nil)
+	if err != nil {
+		t.Fatalf("Convert failed: %v", err)
+	}
+	if result.Cocoa != 664474034 || result.HFSPlus != 3725626034 || result.WebKit != 13287254834000000 {
+		t.Errorf("Cocoa/HFSPlus/WebKit = %v/%d/%d", result.Cocoa, result.HFSPlus, result.WebKit)
+	}
+	if result.GPS.String() != "2193:490052" {
+		t.Errorf("GPS = %q, want 2193:490052", result.GPS.String())
+	}
+}
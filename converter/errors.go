@@ -0,0 +1,210 @@
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrUnknownFormat 無法自動偵測輸入的時間格式
+	ErrUnknownFormat = errors.New("unrecognised time format")
+
+	// ErrUnsupportedFormat 指定的 TimestampFormat 不存在
+	ErrUnsupportedFormat = errors.New("unsupported format")
+
+	// ErrInvalidTimezone 無法載入指定的時區
+	ErrInvalidTimezone = errors.New("invalid time zone")
+
+	// ErrAmbiguousFormat Strict 模式下數字時間戳沒有唯一的合理解讀
+	ErrAmbiguousFormat = errors.New("ambiguous numeric timestamp")
+
+	// ErrInvalidOffset 時間偏移的語法錯誤，見 OffsetError
+	ErrInvalidOffset = errors.New("invalid time offset")
+)
+
+// InputError 的種類，可用 errors.Is(err, ErrInvalidDate) 等方式判斷
+var (
+	// ErrInvalidNumber 數值無法解析或超出範圍
+	ErrInvalidNumber = errors.New("invalid number")
+
+	// ErrInvalidDate 年、月、日、週或序數日不存在
+	ErrInvalidDate = errors.New("invalid date")
+
+	// ErrInvalidClock 時、分、秒超出範圍
+	ErrInvalidClock = errors.New("invalid time of day")
+
+	// ErrInvalidZoneOffset 時區偏移超出範圍
+	ErrInvalidZoneOffset = errors.New("invalid zone offset")
+
+	// ErrUnknownZone 無法辨識的時區縮寫
+	ErrUnknownZone = errors.New("unrecognised time zone abbreviation")
+
+	// ErrPatternMismatch 輸入不符合指定格式的結構
+	ErrPatternMismatch = errors.New("input does not match the format")
+
+	// ErrYearInference 不含年份的日誌時間無法推算年份
+	ErrYearInference = errors.New("cannot infer the year")
+
+	// ErrUnknownPhrase 無法識別的自然語言時間
+	ErrUnknownPhrase = errors.New("unrecognised natural-language time")
+
+	// ErrUnsupportedUnit 不支援的時間單位
+	ErrUnsupportedUnit = errors.New("unsupported time unit")
+
+	// ErrUnexpectedText 輸入中無法解析的內容
+	ErrUnexpectedText = errors.New("unexpected text")
+
+	// ErrFractionalUnit 月與年不支援小數
+	ErrFractionalUnit = errors.New("months and years must be whole numbers")
+
+	// ErrRepeatedUnit 時間偏移中重複的單位
+	ErrRepeatedUnit = errors.New("time unit used more than once")
+
+	// ErrInvalidID ID 的字元或長度不正確
+	ErrInvalidID = errors.New("invalid ID")
+
+	// ErrNoTimestamp ID 的版本不含時間資訊
+	ErrNoTimestamp = errors.New("ID does not contain a time")
+
+	// ErrUnsupportedIDType 不支援的 ID 類型
+	ErrUnsupportedIDType = errors.New("unsupported ID type")
+
+	// ErrOutOfRange 時間超出 ID 或格式可表示的範圍
+	ErrOutOfRange = errors.New("time out of range")
+
+	// ErrInvalidLayout 無效的 Go 版面或 strftime 樣式
+	ErrInvalidLayout = errors.New("invalid layout")
+
+	// ErrUnsupportedDirective 不支援的 strftime 指令
+	ErrUnsupportedDirective = errors.New("unsupported strftime directive")
+)
+
+// FormatError DetectFormat 無法識別輸入時回傳，可用 errors.Is(err, ErrUnknownFormat) 判斷
+type FormatError struct {
+	Input string
+
+	// Numeric 輸入為純數字但長度與範圍不符合任何 Unix 時間戳
+	Numeric bool
+}
+
+func (e *FormatError) Error() string {
+	if e.Numeric {
+		return fmt.Sprintf("%s does not match the length or range of any Unix timestamp", e.Input)
+	}
+	return fmt.Sprintf("unrecognised time format: %s", e.Input)
+}
+
+func (e *FormatError) Unwrap() error {
+	return ErrUnknownFormat
+}
+
+// AmbiguousError Strict 模式下數字時間戳沒有唯一的合理解讀時回傳
+// 可用 errors.Is(err, ErrAmbiguousFormat) 判斷，Candidates 依分數由高至低排序
+type AmbiguousError struct {
+	Input      string
+	Candidates []Candidate
+}
+
+func (e *AmbiguousError) Error() string {
+	if len(e.Candidates) == 0 {
+		return fmt.Sprintf("no interpretation of %s falls within the plausible time range", e.Input)
+	}
+	names := make([]string, len(e.Candidates))
+	for i, c := range e.Candidates {
+		names[i] = fmt.Sprintf("%s (%s)", c.Name, c.Time)
+	}
+	return fmt.Sprintf("%s has several plausible interpretations: %s", e.Input, strings.Join(names, ", "))
+}
+
+func (e *AmbiguousError) Unwrap() error {
+	return ErrAmbiguousFormat
+}
+
+// ParseError 輸入無法以指定格式解析時回傳，Err 為底層的錯誤
+type ParseError struct {
+	Input  string
+	Format TimestampFormat
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	if errors.Is(e.Err, ErrUnsupportedFormat) {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("cannot parse %s as %s: %v", e.Input, e.Format, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// TimezoneError 時區載入失敗時回傳，可用 errors.Is(err, ErrInvalidTimezone) 判斷
+type TimezoneError struct {
+	Name string
+	Err  error
+}
+
+func (e *TimezoneError) Error() string {
+	return fmt.Sprintf("cannot load time zone %s: %v", e.Name, e.Err)
+}
+
+func (e *TimezoneError) Unwrap() []error {
+	return []error{ErrInvalidTimezone, e.Err}
+}
+
+// InputError 輸入中的單一值無效時回傳，通常包裝於 ParseError 或 OffsetError 之內
+// 可用 errors.Is(err, Kind) 判斷種類，呼叫端可依 Kind 與各欄位自行組成訊息
+type InputError struct {
+	// Kind 錯誤種類，為本套件的 Err* 哨兵錯誤之一
+	Kind error
+
+	// Type 值所屬的 ID 或格式類型 (如 "ULID")，與種類無關時為空字串
+	Type string
+
+	// Value 無效的值
+	Value string
+
+	// Position Value 在輸入中的位元組位置 (從 0 起算)，無法定位時為 -1
+	Position int
+}
+
+// inputError 建立位置未知的 InputError
+func inputError(kind error, value string) *InputError {
+	return &InputError{Kind: kind, Value: value, Position: -1}
+}
+
+// atPosition 為位置未知的 InputError 補上在輸入中的位置，其他錯誤原樣回傳
+func atPosition(err error, pos int) error {
+	var inputErr *InputError
+	if errors.As(err, &inputErr) && inputErr.Position < 0 {
+		inputErr.Position = pos
+	}
+	return err
+}
+
+func (e *InputError) Error() string {
+	if e.Type != "" {
+		return fmt.Sprintf("%v (%s): %s", e.Kind, e.Type, e.Value)
+	}
+	return fmt.Sprintf("%v: %s", e.Kind, e.Value)
+}
+
+func (e *InputError) Unwrap() error {
+	return e.Kind
+}
+
+// OffsetError 時間偏移無法解析時回傳，可用 errors.Is(err, ErrInvalidOffset) 判斷
+// Err 通常為 *InputError，其 Position 相對於去除前後空白的 Input
+type OffsetError struct {
+	Input string
+	Err   error
+}
+
+func (e *OffsetError) Error() string {
+	return fmt.Sprintf("invalid time offset %s: %v", e.Input, e.Err)
+}
+
+func (e *OffsetError) Unwrap() []error {
+	return []error{ErrInvalidOffset, e.Err}
+}
@@ -0,0 +1,142 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrorMessages(t *testing.T) {
+	conv := newCandidateConverter()
+	conv.Strict = true
+	_, formatErr := conv.DetectFormat("garbage")
+	_, ambiguousErr := conv.DetectFormat("116444736000000000")
+	_, implausibleErr := conv.DetectFormat("-86400")
+	_, parseErr := conv.Parse("2022-13-45", DateOnly())
+	_, offsetErr := ParseOffset("1h2h")
+	_, timezoneErr := NewConverter("Mars/Olympus_Mons")
+	conv.Strict = false
+	_, numericErr := conv.DetectFormat("123456789012345678901")
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"format", formatErr, "unrecognised time format: garbage"},
+		{"numeric", numericErr, "123456789012345678901 does not match the length or range of any Unix timestamp"},
+		{"ambiguous", ambiguousErr, "116444736000000000 has several plausible interpretations: " +
+			"Unix timestamp (nanoseconds) (1973-09-09T17:45:36Z), Windows FILETIME (1970-01-01T00:00:00Z)"},
+		{"implausible", implausibleErr, "no interpretation of -86400 falls within the plausible time range"},
+		{"parse", parseErr, `cannot parse 2022-13-45 as Date: parsing time "2022-13-45": month out of range`},
+		{"offset", offsetErr, "invalid time offset 1h2h: time unit used more than once: 2h"},
+		{"timezone", timezoneErr, "cannot load time zone Mars/Olympus_Mons: unknown time zone Mars/Olympus_Mons"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.err == nil || tt.err.Error() != tt.want {
+				t.Errorf("Error() = %v, want %q", tt.err, tt.want)
+			}
+		})
+	}
+}
+
+func TestInputErrorKinds(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+	strftime, _ := StrftimeFormat("%Y-%m-%d")
+
+	tests := []struct {
+		name     string
+		input    string
+		format   TimestampFormat
+		kind     error
+		value    string
+		position int
+	}{
+		{"iso week", "2023-W53-1", ISO8601(), ErrInvalidDate, "2023-W53-1", 0},
+		{"iso time", "2024-02-01T25:00", ISO8601(), ErrInvalidClock, "25:00", 11},
+		{"iso zone", "2024-02-01T12:00+24:00", ISO8601(), ErrInvalidZoneOffset, "+24:00", 16},
+		{"zone abbreviation", "Fri, 21 Jan 2022 16:07:14 XYZ", RFC1123(), ErrUnknownZone, "XYZ", -1},
+		{"gps seconds", "2190:700000", GPS(), ErrOutOfRange, "700000", 5},
+		{"excel leap bug", "60", ExcelSerial(), ErrInvalidDate, "1900-02-29", -1},
+		{"ulid", "01ARZ3NDEKTSV4RRFFQ69G5FAVX", ULID(), ErrInvalidID, "01ARZ3NDEKTSV4RRFFQ69G5FAVX", -1},
+		{"uuid v4", "f47ac10b-58cc-4372-a567-0e02b2c3d479", UUID(), ErrNoTimestamp, "f47ac10b-58cc-4372-a567-0e02b2c3d479", -1},
+		{"natural", "the day after forever", NaturalLanguage(), ErrUnknownPhrase, "the day after forever", -1},
+		{"number", "12x", UnixSeconds(), ErrInvalidNumber, "12x", -1},
+		{"strftime", "2024", strftime, nil, "", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := conv.Parse(tt.input, tt.format)
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) || parseErr.Input != tt.input || parseErr.Format != tt.format {
+				t.Fatalf("Parse(%q) error = %v, want *ParseError", tt.input, err)
+			}
+			if tt.kind == nil {
+				return
+			}
+
+			var inputErr *InputError
+			if !errors.As(err, &inputErr) {
+				t.Fatalf("Parse(%q) error = %v, want *InputError", tt.input, err)
+			}
+			if !errors.Is(err, tt.kind) {
+				t.Errorf("Parse(%q) kind = %v, want %v", tt.input, inputErr.Kind, tt.kind)
+			}
+			if inputErr.Value != tt.value || inputErr.Position != tt.position {
+				t.Errorf("Parse(%q) value, position = %q, %d, want %q, %d",
+					tt.input, inputErr.Value, inputErr.Position, tt.value, tt.position)
+			}
+		})
+	}
+}
+
+func TestOffsetErrorPosition(t *testing.T) {
+	tests := []struct {
+		offset   string
+		kind     error
+		value    string
+		position int
+	}{
+		{"-1d x6h", ErrUnexpectedText, " x", 3},
+		{"+2h!", ErrUnexpectedText, "!", 3},
+		{"1.5M", ErrFractionalUnit, "1.5M", -1},
+		{"1h2h", ErrRepeatedUnit, "2h", 2},
+		{"-1d6h1d", ErrRepeatedUnit, "1d", 5},
+		{"+9999999999h", ErrOutOfRange, "9999999999h", -1},
+		{"2562047h48m", ErrOutOfRange, "48m", -1},
+		{"99999999999999999999y", ErrOutOfRange, "99999999999999999999y", -1},
+		{"PT9999999999H", ErrOutOfRange, "9999999999h", -1},
+		{"P1X", ErrPatternMismatch, "P1X", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.offset, func(t *testing.T) {
+			_, err := ParseOffset(tt.offset)
+			var offsetErr *OffsetError
+			if !errors.As(err, &offsetErr) || !errors.Is(err, ErrInvalidOffset) {
+				t.Fatalf("ParseOffset(%q) error = %v, want *OffsetError", tt.offset, err)
+			}
+			var inputErr *InputError
+			if !errors.As(err, &inputErr) || !errors.Is(err, tt.kind) {
+				t.Fatalf("ParseOffset(%q) error = %v, want %v", tt.offset, err, tt.kind)
+			}
+			if inputErr.Value != tt.value || inputErr.Position != tt.position {
+				t.Errorf("ParseOffset(%q) value, position = %q, %d, want %q, %d",
+					tt.offset, inputErr.Value, inputErr.Position, tt.value, tt.position)
+			}
+		})
+	}
+}
+
+func TestStrftimeDirectiveError(t *testing.T) {
+	_, err := StrftimeFormat("%Y-%Q")
+	var inputErr *InputError
+	if !errors.As(err, &inputErr) || !errors.Is(err, ErrUnsupportedDirective) {
+		t.Fatalf("StrftimeFormat error = %v, want ErrUnsupportedDirective", err)
+	}
+	if inputErr.Value != "%Q" || inputErr.Position != 3 {
+		t.Errorf("value, position = %q, %d, want %%Q, 3", inputErr.Value, inputErr.Position)
+	}
+}
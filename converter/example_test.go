@@ -0,0 +1,143 @@
+package converter_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vincent119/timesamp/converter"
+)
+
+func ExampleConverter_Convert() {
+	conv, err := converter.NewConverter("Asia/Taipei")
+	if err != nil {
+		panic(err)
+	}
+	conv.Now = func() time.Time { return time.Date(2022, 1, 24, 16, 7, 14, 0, time.UTC) }
+
+	result, err := conv.Convert("1642781234", nil)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(result.DetectedFormat)
+	fmt.Println(result.RFC3339)
+	fmt.Println(result.UnixMillis)
+	fmt.Println(result.Relative.Text)
+	// Output:
+	// Unix timestamp (seconds)
+	// 2022-01-22T00:07:14+08:00
+	// 1642781234000
+	// 3 days ago
+}
+
+func ExampleConverter_Convert_inputFormat() {
+	conv, _ := converter.NewConverter("UTC")
+
+	format := converter.DateOnly()
+	result, err := conv.Convert("2024-02-29", &format)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(result.UnixSeconds)
+	fmt.Println(result.Weekday)
+	fmt.Println(result.Localized)
+	// Output:
+	// 1709164800
+	// Thursday
+	// Thursday, February 29, 2024 at 00:00:00
+}
+
+func ExampleConverter_DetectFormat() {
+	conv, _ := converter.NewConverter("UTC")
+
+	for _, input := range []string{"1642781234567", "2022-01-21T16:07:14Z", "2022-01-21 16:07:14"} {
+		format, err := conv.DetectFormat(input)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(format)
+	}
+	// Output:
+	// Unix timestamp (milliseconds)
+	// RFC3339
+	// Date and time
+}
+
+func ExampleConverter_Parse() {
+	conv, _ := converter.NewConverter("UTC")
+
+	t, err := conv.Parse("1642781234123456", converter.UnixMicroseconds())
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(t.Format(time.RFC3339Nano))
+	// Output:
+	// 2022-01-21T16:07:14.123456Z
+}
+
+func ExampleLayoutFormat() {
+	conv, _ := converter.NewConverter("UTC")
+
+	format, err := converter.LayoutFormat("02/01/2006 15:04")
+	if err != nil {
+		panic(err)
+	}
+	t, err := conv.Parse("21/01/2022 16:07", format)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(t.Unix())
+	// Output:
+	// 1642781220
+}
+
+func ExampleFormatError() {
+	conv, _ := converter.NewConverter("UTC")
+
+	_, err := conv.Convert("not a timestamp", nil)
+
+	var formatErr *converter.FormatError
+	if errors.As(err, &formatErr) {
+		fmt.Println("input:", formatErr.Input)
+	}
+	fmt.Println(errors.Is(err, converter.ErrUnknownFormat))
+	// Output:
+	// input: not a timestamp
+	// true
+}
+
+func ExampleParseError() {
+	conv, _ := converter.NewConverter("UTC")
+
+	_, err := conv.Parse("2022-13-45", converter.DateOnly())
+
+	var parseErr *converter.ParseError
+	if errors.As(err, &parseErr) {
+		fmt.Println(parseErr.Input, parseErr.Format == converter.DateOnly())
+	}
+	// Output:
+	// 2022-13-45 true
+}
+
+func ExampleInputError() {
+	conv, _ := converter.NewConverter("UTC")
+
+	_, err := conv.Parse("2023-W53-1T12:00Z", converter.ISO8601())
+
+	var inputErr *converter.InputError
+	if errors.As(err, &inputErr) {
+		fmt.Println(inputErr.Value, inputErr.Position)
+	}
+	fmt.Println(errors.Is(err, converter.ErrInvalidDate))
+	// Output:
+	// 2023-W53-1 0
+	// true
+}
+
+func ExampleTimezoneError() {
+	_, err := converter.NewConverter("Mars/Olympus_Mons")
+
+	fmt.Println(errors.Is(err, converter.ErrInvalidTimezone))
+	// Output:
+	// true
+}
@@ -0,0 +1,128 @@
+package converter
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IDRange 某一時刻可能產生的最小與最大 ID，可用於以 ID 排序的範圍查詢
+type IDRange struct {
+	// Type ID 類型，如 ulid、uuid-v7、objectid、snowflake-twitter
+	Type string `json:"type"`
+	Min  string `json:"min"`
+	Max  string `json:"max"`
+}
+
+// IDRangeTypes IDRange 支援的 ID 類型
+var IDRangeTypes = []string{"ulid", "uuid7", "snowflake", "objectid"}
+
+const (
+	// maxMillis48 ULID 與 UUIDv7 的 48 位元毫秒時間上限
+	maxMillis48 = 1<<48 - 1
+
+	// maxSnowflakeMillis Snowflake 的 41 位元毫秒時間上限
+	maxSnowflakeMillis = 1<<41 - 1
+)
+
+// IDRange 取得指定類型的 ID 在時刻 t 的範圍，t 依各 ID 的時間精確度截斷
+// Snowflake 使用 Converter.Snowflake 的紀元
+func (c *Converter) IDRange(kind string, t time.Time) (*IDRange, error) {
+	switch strings.ToLower(kind) {
+	case "ulid":
+		return ulidRange(t)
+	case "uuid7", "uuidv7", "uuid-v7":
+		return uuidV7Range(t)
+	case "snowflake":
+		return snowflakeRange(t, c.snowflakeEpoch())
+	case "objectid":
+		return objectIDRange(t)
+	}
+	return nil, inputError(ErrUnsupportedIDType, kind)
+}
+
+// IDRanges 取得所有支援類型的 ID 在時刻 t 的範圍
+func (c *Converter) IDRanges(t time.Time) ([]IDRange, error) {
+	ranges := make([]IDRange, 0, len(IDRangeTypes))
+	for _, kind := range IDRangeTypes {
+		r, err := c.IDRange(kind, t)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, *r)
+	}
+	return ranges, nil
+}
+
+// ulidRange ULID 以毫秒為單位，範圍為隨機值全 0 至全 1
+func ulidRange(t time.Time) (*IDRange, error) {
+	ms := t.UnixMilli()
+	if ms < 0 || ms > maxMillis48 {
+		return nil, &InputError{Kind: ErrOutOfRange, Type: "ULID", Value: t.Format(time.RFC3339), Position: -1}
+	}
+	prefix := encodeCrockford(uint64(ms), 10)
+	return &IDRange{
+		Type: "ulid",
+		Min:  prefix + strings.Repeat("0", 16),
+		Max:  prefix + strings.Repeat("Z", 16),
+	}, nil
+}
+
+// encodeCrockford 將數值以固定長度的 Crockford Base32 表示
+func encodeCrockford(v uint64, width int) string {
+	b := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		b[i] = crockfordAlphabet[v&0x1f]
+		v >>= 5
+	}
+	return string(b)
+}
+
+// uuidV7Range UUIDv7 以毫秒為單位，範圍為版本與變體以外的位元全 0 至全 1
+func uuidV7Range(t time.Time) (*IDRange, error) {
+	ms := t.UnixMilli()
+	if ms < 0 || ms > maxMillis48 {
+		return nil, &InputError{Kind: ErrOutOfRange, Type: "UUIDv7", Value: t.Format(time.RFC3339), Position: -1}
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(ms))
+	ts := hex.EncodeToString(b[2:])
+	prefix := ts[:8] + "-" + ts[8:] + "-"
+	return &IDRange{
+		Type: "uuid-v7",
+		Min:  prefix + "7000-8000-000000000000",
+		Max:  prefix + "7fff-bfff-ffffffffffff",
+	}, nil
+}
+
+// snowflakeRange Snowflake 以毫秒為單位，範圍為機器與序號欄位全 0 至全 1
+func snowflakeRange(t time.Time, epoch SnowflakeEpoch) (*IDRange, error) {
+	ms := t.Sub(epoch.Epoch).Milliseconds()
+	// Sub 會將超出 time.Duration 的差距截斷，另以 Before 判斷紀元之前的時間
+	if t.Before(epoch.Epoch) || ms > maxSnowflakeMillis {
+		return nil, &InputError{Kind: ErrOutOfRange, Type: "Snowflake " + epoch.Name, Value: t.Format(time.RFC3339), Position: -1}
+	}
+	id := uint64(ms) << 22
+	return &IDRange{
+		Type: "snowflake-" + epoch.Name,
+		Min:  strconv.FormatUint(id, 10),
+		Max:  strconv.FormatUint(id|(1<<22-1), 10),
+	}, nil
+}
+
+// objectIDRange ObjectID 以秒為單位，範圍為隨機值與計數器全 0 至全 1
+func objectIDRange(t time.Time) (*IDRange, error) {
+	secs := t.Unix()
+	if secs < 0 || secs > 1<<32-1 {
+		return nil, &InputError{Kind: ErrOutOfRange, Type: "ObjectID", Value: t.Format(time.RFC3339), Position: -1}
+	}
+	prefix := fmt.Sprintf("%08x", secs)
+	return &IDRange{
+		Type: "objectid",
+		Min:  prefix + strings.Repeat("0", 16),
+		Max:  prefix + strings.Repeat("f", 16),
+	}, nil
+}
@@ -0,0 +1,91 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIDRanges(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+	instant := time.Date(2022, 1, 21, 16, 7, 14, 567000000, time.UTC)
+
+	ranges, err := conv.IDRanges(instant)
+	if err != nil {
+		t.Fatalf("IDRanges error = %v", err)
+	}
+
+	want := []IDRange{
+		{"ulid", "01FSYP8NC70000000000000000", "01FSYP8NC7ZZZZZZZZZZZZZZZZ"},
+		{"uuid-v7", "017e7d64-5587-7000-8000-000000000000", "017e7d64-5587-7fff-bfff-ffffffffffff"},
+		{"snowflake-twitter", "1484558213725552640", "1484558213729746943"},
+		{"objectid", "61eada320000000000000000", "61eada32ffffffffffffffff"},
+	}
+	if len(ranges) != len(want) {
+		t.Fatalf("IDRanges = %v", ranges)
+	}
+	for i := range want {
+		if ranges[i] != want[i] {
+			t.Errorf("IDRanges[%d] = %+v, want %+v", i, ranges[i], want[i])
+		}
+	}
+}
+
+func TestIDRangeRoundTrip(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+	conv.Snowflake, _ = LookupSnowflakeEpoch("discord")
+	instant := time.Date(2023, 6, 30, 23, 59, 59, 999000000, time.UTC)
+
+	tests := []struct {
+		kind      string
+		format    TimestampFormat
+		precision time.Duration
+	}{
+		{"ulid", ULID(), time.Millisecond},
+		{"uuid7", UUID(), time.Millisecond},
+		{"snowflake", Snowflake(), time.Millisecond},
+		{"objectid", ObjectID(), time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			r, err := conv.IDRange(tt.kind, instant)
+			if err != nil {
+				t.Fatalf("IDRange error = %v", err)
+			}
+			for _, id := range []string{r.Min, r.Max} {
+				got, err := conv.Parse(id, tt.format)
+				if err != nil {
+					t.Fatalf("Parse(%q) error = %v", id, err)
+				}
+				if want := instant.Truncate(tt.precision); !got.Equal(want) {
+					t.Errorf("Parse(%q) = %v, want %v", id, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestIDRangeErrors(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		name string
+		kind string
+		t    time.Time
+	}{
+		{"unknown type", "uuid4", time.Unix(0, 0)},
+		{"ULID before 1970", "ulid", time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"ObjectID after 2106", "objectid", time.Date(2107, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"snowflake before epoch", "snowflake", time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"snowflake after 41 bits", "snowflake", time.Date(2081, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := conv.IDRange(tt.kind, tt.t); err == nil {
+				t.Errorf("IDRange(%s, %v) expected error", tt.kind, tt.t)
+			}
+		})
+	}
+}
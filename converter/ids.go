@@ -0,0 +1,292 @@
+package converter
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IDField 從 ID 解出的欄位
+type IDField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// IDInfo 從含有時間的 ID 解出的資訊
+type IDInfo struct {
+	// Type ID 類型，如 ulid、uuid-v7、ksuid、objectid、snowflake-twitter
+	Type   string    `json:"type"`
+	Fields []IDField `json:"fields"`
+
+	// Time ID 中的時間
+	Time time.Time `json:"-"`
+}
+
+// SnowflakeEpoch Snowflake ID 的紀元與機器欄位配置
+// 機器欄位共 10 位元；HighField 與 LowField 為空時以單一 machine_id 表示
+type SnowflakeEpoch struct {
+	Name      string
+	Epoch     time.Time
+	HighField string
+	LowField  string
+}
+
+// SnowflakeEpochs 內建的 Snowflake 紀元，第一個為預設值
+var SnowflakeEpochs = []SnowflakeEpoch{
+	{"twitter", time.UnixMilli(1288834974657).UTC(), "datacenter_id", "worker_id"},
+	{"discord", time.UnixMilli(1420070400000).UTC(), "worker_id", "process_id"},
+}
+
+// LookupSnowflakeEpoch 依名稱取得內建的 Snowflake 紀元
+func LookupSnowflakeEpoch(name string) (SnowflakeEpoch, bool) {
+	for _, e := range SnowflakeEpochs {
+		if strings.EqualFold(e.Name, name) {
+			return e, true
+		}
+	}
+	return SnowflakeEpoch{}, false
+}
+
+// CustomSnowflakeEpoch 建立自訂紀元的 Snowflake 配置
+func CustomSnowflakeEpoch(epoch time.Time) SnowflakeEpoch {
+	return SnowflakeEpoch{Name: "custom", Epoch: epoch}
+}
+
+const (
+	// crockfordAlphabet ULID 使用的 Crockford Base32 字元
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+	// base62Alphabet KSUID 使用的 Base62 字元
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	// ksuidEpoch KSUID 紀元 (2014-05-13 16:53:20 UTC) 的 Unix 秒數
+	ksuidEpoch = 1400000000
+
+	// gregorianOffset UUID v1/v6 紀元 (1582-10-15) 至 Unix 紀元的 100 奈秒刻度
+	gregorianOffset = 122192928000000000
+)
+
+var (
+	ulidPattern     = regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`)
+	uuidPattern     = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	ksuidPattern    = regexp.MustCompile(`^[0-9A-Za-z]{27}$`)
+	objectIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{24}$`)
+)
+
+// detectID 判斷輸入是否為含有時間的 ID 格式
+// Snowflake 與一般數字時間戳無法區分，只能以 -i snowflake 指定
+func detectID(input string) (TimestampFormat, bool) {
+	switch {
+	case uuidPattern.MatchString(input):
+		return UUID(), true
+	case ulidPattern.MatchString(input):
+		return ULID(), true
+	case objectIDPattern.MatchString(input):
+		return ObjectID(), true
+	case ksuidPattern.MatchString(input):
+		return KSUID(), true
+	}
+	return TimestampFormat{}, false
+}
+
+// isIDFormat 判斷格式是否為 ID 格式
+func isIDFormat(format TimestampFormat) bool {
+	switch format {
+	case Snowflake(), ULID(), UUID(), KSUID(), ObjectID():
+		return true
+	}
+	return false
+}
+
+// DecodeID 解出 ID 中的時間與欄位，format 必須是 Snowflake、ULID、UUID、KSUID 或 ObjectID
+func (c *Converter) DecodeID(input string, format TimestampFormat) (*IDInfo, error) {
+	input = strings.TrimSpace(input)
+
+	var info *IDInfo
+	var err error
+	switch format {
+	case Snowflake():
+		info, err = decodeSnowflake(input, c.snowflakeEpoch())
+	case ULID():
+		info, err = decodeULID(input)
+	case UUID():
+		info, err = decodeUUID(input)
+	case KSUID():
+		info, err = decodeKSUID(input)
+	case ObjectID():
+		info, err = decodeObjectID(input)
+	default:
+		return nil, &ParseError{Input: input, Format: format, Err: ErrUnsupportedFormat}
+	}
+	if err != nil {
+		return nil, &ParseError{Input: input, Format: format, Err: err}
+	}
+	info.Time = info.Time.In(c.Location)
+	return info, nil
+}
+
+// snowflakeEpoch 取得 Snowflake 紀元，未設定時使用 Twitter 紀元
+func (c *Converter) snowflakeEpoch() SnowflakeEpoch {
+	if c.Snowflake.Epoch.IsZero() {
+		return SnowflakeEpochs[0]
+	}
+	return c.Snowflake
+}
+
+// decodeSnowflake 解析 Snowflake ID：41 位元毫秒時間、10 位元機器、12 位元序號
+func decodeSnowflake(input string, epoch SnowflakeEpoch) (*IDInfo, error) {
+	id, err := strconv.ParseUint(input, 10, 63)
+	if err != nil {
+		return nil, &InputError{Kind: ErrInvalidID, Type: "Snowflake", Value: input, Position: -1}
+	}
+
+	ms := int64(id >> 22)
+	machine := (id >> 12) & 0x3ff
+	info := &IDInfo{
+		Type: "snowflake-" + epoch.Name,
+		Time: epoch.Epoch.Add(time.Duration(ms) * time.Millisecond),
+		Fields: []IDField{
+			{"epoch", epoch.Epoch.Format(time.RFC3339Nano)},
+			{"timestamp_ms", strconv.FormatInt(ms, 10)},
+		},
+	}
+	if epoch.HighField != "" {
+		info.Fields = append(info.Fields,
+			IDField{epoch.HighField, strconv.FormatUint(machine>>5, 10)},
+			IDField{epoch.LowField, strconv.FormatUint(machine&0x1f, 10)})
+	} else {
+		info.Fields = append(info.Fields, IDField{"machine_id", strconv.FormatUint(machine, 10)})
+	}
+	info.Fields = append(info.Fields, IDField{"sequence", strconv.FormatUint(id&0xfff, 10)})
+	return info, nil
+}
+
+// decodeULID 解析 ULID：48 位元毫秒時間與 80 位元隨機值
+func decodeULID(input string) (*IDInfo, error) {
+	if !ulidPattern.MatchString(input) {
+		return nil, &InputError{Kind: ErrInvalidID, Type: "ULID", Value: input, Position: -1}
+	}
+
+	// 26 個字元共 130 位元，最高 2 位元恆為 0
+	var hi, lo uint64
+	for _, ch := range strings.ToUpper(input) {
+		v := uint64(strings.IndexRune(crockfordAlphabet, ch))
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | v
+	}
+	var b [16]byte
+	binary.BigEndian.PutUint64(b[:8], hi)
+	binary.BigEndian.PutUint64(b[8:], lo)
+
+	ms := int64(hi >> 16)
+	return &IDInfo{
+		Type: "ulid",
+		Time: time.UnixMilli(ms),
+		Fields: []IDField{
+			{"timestamp_ms", strconv.FormatInt(ms, 10)},
+			{"randomness", hex.EncodeToString(b[6:])},
+		},
+	}, nil
+}
+
+// decodeUUID 解析 UUID v1、v6 與 v7，其他版本不含時間資訊
+func decodeUUID(input string) (*IDInfo, error) {
+	if !uuidPattern.MatchString(input) {
+		return nil, &InputError{Kind: ErrInvalidID, Type: "UUID", Value: input, Position: -1}
+	}
+	b, _ := hex.DecodeString(strings.ReplaceAll(input, "-", ""))
+
+	version := int(b[6] >> 4)
+	info := &IDInfo{
+		Type:   fmt.Sprintf("uuid-v%d", version),
+		Fields: []IDField{{"version", strconv.Itoa(version)}},
+	}
+
+	switch version {
+	case 1, 6:
+		var ticks uint64
+		timeHigh := uint64(binary.BigEndian.Uint16(b[6:8]) & 0x0fff)
+		if version == 1 {
+			ticks = timeHigh<<48 | uint64(binary.BigEndian.Uint16(b[4:6]))<<32 | uint64(binary.BigEndian.Uint32(b[0:4]))
+		} else {
+			ticks = uint64(binary.BigEndian.Uint32(b[0:4]))<<28 | uint64(binary.BigEndian.Uint16(b[4:6]))<<12 | timeHigh
+		}
+		info.Time = ticksToTime(int64(ticks)-gregorianOffset, 0)
+		info.Fields = append(info.Fields,
+			IDField{"clock_sequence", strconv.Itoa(int(binary.BigEndian.Uint16(b[8:10]) & 0x3fff))},
+			IDField{"node", formatNode(b[10:16])})
+	case 7:
+		ms := int64(binary.BigEndian.Uint64(append([]byte{0, 0}, b[0:6]...)))
+		random := append([]byte{b[6] & 0x0f, b[7], b[8] & 0x3f}, b[9:]...)
+		info.Time = time.UnixMilli(ms)
+		info.Fields = append(info.Fields,
+			IDField{"timestamp_ms", strconv.FormatInt(ms, 10)},
+			IDField{"randomness", hex.EncodeToString(random)})
+	default:
+		return nil, &InputError{Kind: ErrNoTimestamp, Type: fmt.Sprintf("UUID v%d", version), Value: input, Position: -1}
+	}
+	return info, nil
+}
+
+// formatNode 以冒號分隔的十六進位表示 UUID 的 node 欄位
+func formatNode(b []byte) string {
+	parts := make([]string, len(b))
+	for i, v := range b {
+		parts[i] = fmt.Sprintf("%02x", v)
+	}
+	return strings.Join(parts, ":")
+}
+
+// decodeKSUID 解析 KSUID：32 位元秒數 (自 2014-05-13 起) 與 128 位元 payload
+func decodeKSUID(input string) (*IDInfo, error) {
+	if !ksuidPattern.MatchString(input) {
+		return nil, &InputError{Kind: ErrInvalidID, Type: "KSUID", Value: input, Position: -1}
+	}
+
+	n := new(big.Int)
+	base := big.NewInt(62)
+	for _, ch := range input {
+		n.Mul(n, base)
+		n.Add(n, big.NewInt(int64(strings.IndexRune(base62Alphabet, ch))))
+	}
+	if n.BitLen() > 160 {
+		return nil, &InputError{Kind: ErrOutOfRange, Type: "KSUID", Value: input, Position: -1}
+	}
+	var b [20]byte
+	n.FillBytes(b[:])
+
+	secs := int64(binary.BigEndian.Uint32(b[0:4]))
+	return &IDInfo{
+		Type: "ksuid",
+		Time: time.Unix(secs+ksuidEpoch, 0),
+		Fields: []IDField{
+			{"timestamp", strconv.FormatInt(secs, 10)},
+			{"payload", hex.EncodeToString(b[4:])},
+		},
+	}, nil
+}
+
+// decodeObjectID 解析 MongoDB ObjectID：32 位元秒數、5 位元組隨機值與 3 位元組計數器
+func decodeObjectID(input string) (*IDInfo, error) {
+	if !objectIDPattern.MatchString(input) {
+		return nil, &InputError{Kind: ErrInvalidID, Type: "ObjectID", Value: input, Position: -1}
+	}
+	b, _ := hex.DecodeString(input)
+
+	secs := int64(binary.BigEndian.Uint32(b[0:4]))
+	counter := uint32(b[9])<<16 | uint32(b[10])<<8 | uint32(b[11])
+	return &IDInfo{
+		Type: "objectid",
+		Time: time.Unix(secs, 0),
+		Fields: []IDField{
+			{"timestamp", strconv.FormatInt(secs, 10)},
+			{"random", hex.EncodeToString(b[4:9])},
+			{"counter", strconv.FormatUint(uint64(counter), 10)},
+		},
+	}, nil
+}
@@ -0,0 +1,193 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDetectID(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		input string
+		want  TimestampFormat
+	}{
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAV", ULID()},
+		{"01arz3ndektsv4rrffq69g5fav", ULID()},
+		{"017F22E2-79B0-7CC3-98C4-DC0C0C07398F", UUID()},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d479", UUID()},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLOv", KSUID()},
+		{"507f1f77bcf86cd799439011", ObjectID()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := conv.DetectFormat(tt.input)
+			if err != nil {
+				t.Fatalf("DetectFormat(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("DetectFormat(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeID(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		name     string
+		input    string
+		format   TimestampFormat
+		wantType string
+		want     time.Time
+		fields   map[string]string
+	}{
+		{
+			"ULID", "01ARZ3NDEKTSV4RRFFQ69G5FAV", ULID(), "ulid",
+			time.UnixMilli(1469922850259),
+			map[string]string{"randomness": "d6764c61efb99302bd5b"},
+		},
+		{
+			"UUIDv1", "c232ab00-9414-11ec-b3c8-9f6bdeced846", UUID(), "uuid-v1",
+			time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC),
+			map[string]string{"clock_sequence": "13256", "node": "9f:6b:de:ce:d8:46"},
+		},
+		{
+			"UUIDv6", "1EC9414C-232A-6B00-B3C8-9F6BDECED846", UUID(), "uuid-v6",
+			time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC),
+			map[string]string{"version": "6", "node": "9f:6b:de:ce:d8:46"},
+		},
+		{
+			"UUIDv7", "017F22E2-79B0-7CC3-98C4-DC0C0C07398F", UUID(), "uuid-v7",
+			time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC),
+			map[string]string{"randomness": "0cc318c4dc0c0c07398f"},
+		},
+		{
+			"KSUID", "0ujtsYcgvSTl8PAuAdqWYSMnLOv", KSUID(), "ksuid",
+			time.Date(2017, 10, 10, 4, 0, 47, 0, time.UTC),
+			map[string]string{"payload": "b5a1cd34b5f99d1154fb6853345c9735"},
+		},
+		{
+			"ObjectID", "507f1f77bcf86cd799439011", ObjectID(), "objectid",
+			time.Date(2012, 10, 17, 21, 13, 27, 0, time.UTC),
+			map[string]string{"random": "bcf86cd799", "counter": "4427793"},
+		},
+		{
+			"Twitter snowflake", "1541815603606036480", Snowflake(), "snowflake-twitter",
+			time.UnixMilli(1656432460105),
+			map[string]string{"datacenter_id": "11", "worker_id": "26", "sequence": "0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := conv.DecodeID(tt.input, tt.format)
+			if err != nil {
+				t.Fatalf("DecodeID(%q) error = %v", tt.input, err)
+			}
+			if info.Type != tt.wantType {
+				t.Errorf("Type = %q, want %q", info.Type, tt.wantType)
+			}
+			if !info.Time.Equal(tt.want) {
+				t.Errorf("Time = %v, want %v", info.Time, tt.want)
+			}
+			got := map[string]string{}
+			for _, f := range info.Fields {
+				got[f.Name] = f.Value
+			}
+			for name, want := range tt.fields {
+				if got[name] != want {
+					t.Errorf("field %s = %q, want %q", name, got[name], want)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeSnowflakeEpochs(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	discord, ok := LookupSnowflakeEpoch("Discord")
+	if !ok {
+		t.Fatal("LookupSnowflakeEpoch(Discord) not found")
+	}
+	conv.Snowflake = discord
+
+	info, err := conv.DecodeID("175928847299117063", Snowflake())
+	if err != nil {
+		t.Fatalf("DecodeID error = %v", err)
+	}
+	if want := time.UnixMilli(1462015105796); !info.Time.Equal(want) {
+		t.Errorf("Discord Time = %v, want %v", info.Time, want)
+	}
+	if info.Fields[2] != (IDField{"worker_id", "1"}) || info.Fields[3] != (IDField{"process_id", "0"}) {
+		t.Errorf("Discord Fields = %v", info.Fields)
+	}
+
+	// 自訂紀元以單一 machine_id 表示機器欄位
+	conv.Snowflake = CustomSnowflakeEpoch(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	info, err = conv.DecodeID("1234567890123456", Snowflake())
+	if err != nil {
+		t.Fatalf("DecodeID error = %v", err)
+	}
+	if want := time.Date(2020, 1, 4, 9, 45, 43, 922000000, time.UTC); !info.Time.Equal(want) {
+		t.Errorf("custom Time = %v, want %v", info.Time, want)
+	}
+	if info.Type != "snowflake-custom" || info.Fields[2] != (IDField{"machine_id", "171"}) {
+		t.Errorf("custom = %s %v", info.Type, info.Fields)
+	}
+}
+
+func TestConvertReportsIDFields(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	result, err := conv.Convert("507f1f77bcf86cd799439011", nil)
+	if err != nil {
+		t.Fatalf("Convert error = %v", err)
+	}
+	if result.ID == nil || result.ID.Type != "objectid" {
+		t.Fatalf("ID = %+v", result.ID)
+	}
+	if result.UnixSeconds != 1350508407 {
+		t.Errorf("UnixSeconds = %d", result.UnixSeconds)
+	}
+
+	// 非 ID 格式不回報 ID 欄位
+	result, _ = conv.Convert("1642781234", nil)
+	if result.ID != nil {
+		t.Errorf("ID = %+v, want nil", result.ID)
+	}
+}
+
+func TestDecodeIDErrors(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		name   string
+		input  string
+		format TimestampFormat
+	}{
+		{"UUID v4 has no time", "f47ac10b-58cc-4372-a567-0e02b2c3d479", UUID()},
+		{"invalid ULID", "81ARZ3NDEKTSV4RRFFQ69G5FAV", ULID()},
+		{"KSUID overflow", "zzzzzzzzzzzzzzzzzzzzzzzzzzz", KSUID()},
+		{"negative snowflake", "-1", Snowflake()},
+		{"short ObjectID", "507f1f77", ObjectID()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := conv.Parse(tt.input, tt.format)
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse(%q) error = %v, want *ParseError", tt.input, err)
+			}
+			if parseErr.Format != tt.format {
+				t.Errorf("ParseError.Format = %v, want %v", parseErr.Format, tt.format)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package converter
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// iso8601Pattern ISO 8601 日期與時間的整體結構，支援延伸與基本格式
+	// 日期：2024-02-01、20240201、2024-02、2024、2024-W05-3、2024W053、2024-W05、2024-032、2024032
+	// 時間：12:00:00、120000、12:00、1200、12，最低位可帶小數 (小數點或逗號)
+	// 時區：Z、+08:00、+0800、+08
+	iso8601Pattern = regexp.MustCompile(`^(\d{4}(?:-\d{2}(?:-\d{2})?|\d{4}|-?W\d{2}(?:-?\d)?|-?\d{3})?)` +
+		`(?:[Tt ](\d{2}(?::?\d{2}(?::?\d{2})?)?(?:[.,]\d+)?)(Z|z|[+-]\d{2}(?::?\d{2})?)?)?$`)
+
+	isoCalendarPattern = regexp.MustCompile(`^(\d{4})(?:-(\d{2})(?:-(\d{2}))?|(\d{2})(\d{2}))?$`)
+	isoWeekPattern     = regexp.MustCompile(`^(\d{4})-?W(\d{2})(?:-?(\d))?$`)
+	isoOrdinalPattern  = regexp.MustCompile(`^(\d{4})-?(\d{3})$`)
+	isoTimePattern     = regexp.MustCompile(`^(\d{2})(?::?(\d{2})(?::?(\d{2}))?)?(?:[.,](\d+))?$`)
+)
+
+// isISO8601 判斷輸入是否具有 ISO 8601 的結構
+// 純數字 (如 20240201、2024) 與數字時間戳無法區分，只在指定 -i iso8601 時以 ISO 8601 解析
+func isISO8601(input string) bool {
+	return !decimalPattern.MatchString(input) && iso8601Pattern.MatchString(input)
+}
+
+// parseISO8601 解析 ISO 8601 日期時間
+// 支援日曆日期、週日期與序數日期的延伸與基本格式、省略較低位的精確度，以及最低位的小數
+// 未指定時區時以 Converter.Location 的牆上時間解讀
+func (c *Converter) parseISO8601(input string) (time.Time, error) {
+	idx := iso8601Pattern.FindStringSubmatchIndex(input)
+	if idx == nil {
+		return time.Time{}, &InputError{Kind: ErrPatternMismatch, Type: "ISO 8601", Value: input, Position: -1}
+	}
+	m := make([]string, len(idx)/2)
+	for i := range m {
+		if idx[2*i] >= 0 {
+			m[i] = input[idx[2*i]:idx[2*i+1]]
+		}
+	}
+
+	year, month, day, err := parseISODate(m[1])
+	if err != nil {
+		return time.Time{}, atPosition(err, idx[2])
+	}
+
+	loc := c.Location
+	if m[3] != "" {
+		if loc, err = parseISOZone(m[3]); err != nil {
+			return time.Time{}, atPosition(err, idx[6])
+		}
+	}
+
+	var clock time.Duration
+	if m[2] != "" {
+		if clock, err = parseISOTime(m[2]); err != nil {
+			return time.Time{}, atPosition(err, idx[4])
+		}
+	}
+	// 以奈秒欄位傳入當天經過的時間，由 time.Date 依牆上時間正規化，避免跨越日光節約時間切換時偏移
+	return time.Date(year, month, day, 0, 0, 0, int(clock), loc).In(c.Location), nil
+}
+
+// parseISODate 解析 ISO 8601 日期部分，回傳的日可能超出當月天數，由 time.Date 正規化
+func parseISODate(s string) (int, time.Month, int, error) {
+	if m := isoCalendarPattern.FindStringSubmatch(s); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		monthStr, dayStr := m[2]+m[4], m[3]+m[5]
+		month, day := 1, 1
+		if monthStr != "" {
+			month, _ = strconv.Atoi(monthStr)
+			if month < 1 || month > 12 {
+				return 0, 0, 0, inputError(ErrInvalidDate, s)
+			}
+		}
+		if dayStr != "" {
+			day, _ = strconv.Atoi(dayStr)
+			if day < 1 || day > daysIn(year, time.Month(month)) {
+				return 0, 0, 0, inputError(ErrInvalidDate, s)
+			}
+		}
+		return year, time.Month(month), day, nil
+	}
+
+	if m := isoWeekPattern.FindStringSubmatch(s); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		week, _ := strconv.Atoi(m[2])
+		weekday := 1
+		if m[3] != "" {
+			weekday, _ = strconv.Atoi(m[3])
+		}
+		if week < 1 || week > isoWeeksIn(year) {
+			return 0, 0, 0, inputError(ErrInvalidDate, s)
+		}
+		if weekday < 1 || weekday > 7 {
+			return 0, 0, 0, inputError(ErrInvalidDate, s)
+		}
+		// ISO 週年的第一週包含 1 月 4 日，自該週的星期一起算
+		return year, time.January, isoWeekOneMonday(year) + (week-1)*7 + weekday - 1, nil
+	}
+
+	if m := isoOrdinalPattern.FindStringSubmatch(s); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		yday, _ := strconv.Atoi(m[2])
+		if yday < 1 || yday > daysInYear(year) {
+			return 0, 0, 0, inputError(ErrInvalidDate, s)
+		}
+		return year, time.January, yday, nil
+	}
+
+	return 0, 0, 0, inputError(ErrInvalidDate, s)
+}
+
+// parseISOTime 解析 ISO 8601 時間部分為當天經過的時間，最低位的小數精確換算至奈秒
+// 接受代表一天結束的 24:00
+func parseISOTime(s string) (time.Duration, error) {
+	m := isoTimePattern.FindStringSubmatch(s)
+	if m == nil {
+		return 0, inputError(ErrInvalidClock, s)
+	}
+
+	units := []struct {
+		value string
+		unit  time.Duration
+		max   int
+	}{
+		{m[1], time.Hour, 24},
+		{m[2], time.Minute, 59},
+		{m[3], time.Second, 59},
+	}
+
+	var clock time.Duration
+	lowest := time.Hour
+	for _, u := range units {
+		if u.value == "" {
+			break
+		}
+		n, _ := strconv.Atoi(u.value)
+		if n > u.max {
+			return 0, inputError(ErrInvalidClock, s)
+		}
+		clock += time.Duration(n) * u.unit
+		lowest = u.unit
+	}
+	clock += time.Duration(roundFraction(m[4], int64(lowest)))
+
+	if m[1] == "24" && clock != 24*time.Hour {
+		return 0, inputError(ErrInvalidClock, s)
+	}
+	return clock, nil
+}
+
+// parseISOZone 解析 ISO 8601 時區標示 (Z、±hh:mm、±hhmm、±hh)
+func parseISOZone(s string) (*time.Location, error) {
+	if s == "Z" || s == "z" {
+		return time.UTC, nil
+	}
+
+	digits := strings.ReplaceAll(s[1:], ":", "")
+	hours, _ := strconv.Atoi(digits[:2])
+	minutes := 0
+	if len(digits) == 4 {
+		minutes, _ = strconv.Atoi(digits[2:])
+	}
+	if hours > 23 || minutes > 59 {
+		return nil, inputError(ErrInvalidZoneOffset, s)
+	}
+
+	offset := hours*3600 + minutes*60
+	if s[0] == '-' {
+		offset = -offset
+	}
+	return time.FixedZone("", offset), nil
+}
+
+// isoWeekOneMonday ISO 週年第一週的星期一，以該年 1 月的日 (可能小於 1) 表示
+func isoWeekOneMonday(year int) int {
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	return 4 - (int(jan4.Weekday())+6)%7
+}
+
+// isoWeeksIn ISO 週年的週數 (52 或 53)
+func isoWeeksIn(year int) int {
+	_, weeks := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return weeks
+}
+
+// daysIn 指定月份的天數
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// daysInYear 指定年份的天數
+func daysInYear(year int) int {
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+// FormatISOWeek 將時間格式化為 ISO 8601 週日期 (如 2024-W05-3)，以時間所在時區的牆上時間計算
+func FormatISOWeek(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d-%d", year, week, (int(t.Weekday())+6)%7+1)
+}
+
+// FormatOrdinal 將時間格式化為 ISO 8601 序數日期 (如 2024-032)，以時間所在時區的牆上時間計算
+func FormatOrdinal(t time.Time) string {
+	return fmt.Sprintf("%04d-%03d", t.Year(), t.YearDay())
+}
+
+// FormatISO8601Basic 將時間格式化為 ISO 8601 基本格式 (如 20240201T120000Z)，有小數秒時一併輸出
+func FormatISO8601Basic(t time.Time) string {
+	if _, offset := t.Zone(); offset == 0 {
+		return t.Format("20060102T150405.999999999Z")
+	}
+	return t.Format("20060102T150405.999999999-0700")
+}
@@ -0,0 +1,171 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseISO8601(t *testing.T) {
+	conv, _ := NewConverter("Asia/Taipei")
+	taipei := conv.Location
+
+	tests := []struct {
+		name  string
+		input string
+		want  time.Time
+	}{
+		{"week date", "2024-W05-3", time.Date(2024, 1, 31, 0, 0, 0, 0, taipei)},
+		{"week date basic", "2024W053", time.Date(2024, 1, 31, 0, 0, 0, 0, taipei)},
+		{"week without day", "2024-W05", time.Date(2024, 1, 29, 0, 0, 0, 0, taipei)},
+		{"week one starts in previous year", "2025-W01-1", time.Date(2024, 12, 30, 0, 0, 0, 0, taipei)},
+		{"week 53", "2020-W53-7", time.Date(2021, 1, 3, 0, 0, 0, 0, taipei)},
+		{"ordinal date", "2024-032", time.Date(2024, 2, 1, 0, 0, 0, 0, taipei)},
+		{"ordinal date basic", "2024032", time.Date(2024, 2, 1, 0, 0, 0, 0, taipei)},
+		{"ordinal leap day", "2024-366", time.Date(2024, 12, 31, 0, 0, 0, 0, taipei)},
+		{"calendar basic", "20240201", time.Date(2024, 2, 1, 0, 0, 0, 0, taipei)},
+		{"year and month", "2024-02", time.Date(2024, 2, 1, 0, 0, 0, 0, taipei)},
+		{"year only", "2024", time.Date(2024, 1, 1, 0, 0, 0, 0, taipei)},
+		{"basic date time UTC", "20240201T120000Z", time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)},
+		{"basic with offset", "20240201T200000+0800", time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)},
+		{"no seconds", "2024-02-01T12:00", time.Date(2024, 2, 1, 12, 0, 0, 0, taipei)},
+		{"hours only", "2024-02-01T12Z", time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)},
+		{"hour offset", "2024-02-01T20:00+08", time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)},
+		{"comma decimal seconds", "2024-02-01T12:00:00,5Z", time.Date(2024, 2, 1, 12, 0, 0, 500000000, time.UTC)},
+		{"decimal minutes", "2024-02-01T12:30.5Z", time.Date(2024, 2, 1, 12, 30, 30, 0, time.UTC)},
+		{"decimal hours", "2024-02-01T12,25Z", time.Date(2024, 2, 1, 12, 15, 0, 0, time.UTC)},
+		{"week date with time", "2024-W05-3T12:00:00Z", time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)},
+		{"ordinal with time", "2024-032T12:00Z", time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)},
+		{"end of day", "2024-02-01T24:00", time.Date(2024, 2, 2, 0, 0, 0, 0, taipei)},
+		{"space separator", "2024-02-01 12:00", time.Date(2024, 2, 1, 12, 0, 0, 0, taipei)},
+		{"seconds without zone", "2024-02-01T12:00:00", time.Date(2024, 2, 1, 12, 0, 0, 0, taipei)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := conv.Parse(tt.input, ISO8601())
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse(%q) = %s, want %s", tt.input, got.Format(time.RFC3339Nano), tt.want.Format(time.RFC3339Nano))
+			}
+			if got.Location() != taipei {
+				t.Errorf("Parse(%q) location = %v, want %v", tt.input, got.Location(), taipei)
+			}
+		})
+	}
+}
+
+func TestParseISO8601DST(t *testing.T) {
+	conv, _ := NewConverter("America/New_York")
+
+	// 2024-03-10 凌晨 2 點切換為夏令時間，牆上時間 12:00 為 EDT
+	got, err := conv.Parse("2024-03-10T12:00", ISO8601())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 3, 10, 16, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Parse = %s, want %s", got.Format(time.RFC3339), want.Format(time.RFC3339))
+	}
+}
+
+func TestParseISO8601Rejects(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	for _, input := range []string{
+		"2024-13",
+		"2023-02-29",
+		"2023-W53-1",
+		"2024-W05-8",
+		"2023-366",
+		"2024-000",
+		"2024-02-01T25:00",
+		"2024-02-01T12:60",
+		"2024-02-01T24:00:01",
+		"2024-02-01T12:00+24:00",
+		"202402",
+		"2024-02-01T",
+	} {
+		t.Run(input, func(t *testing.T) {
+			if got, err := conv.Parse(input, ISO8601()); err == nil {
+				t.Errorf("Parse(%q) = %v, want error", input, got)
+			}
+		})
+	}
+}
+
+func TestDetectISO8601(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		input string
+		want  TimestampFormat
+	}{
+		{"2024-W05-3", ISO8601()},
+		{"2024-032", ISO8601()},
+		{"20240201T120000Z", ISO8601()},
+		{"2024-02-01T12:00", ISO8601()},
+		{"2024-02-01T12:00:00", ISO8601()},
+		{"2024-02-01T12:00:00,5Z", ISO8601()},
+		{"2024-02", ISO8601()},
+		// 既有格式維持原本的偵測結果
+		{"2024-02-01T12:00:00Z", RFC3339()},
+		{"2024-02-01T12:00:00.5+08:00", RFC3339Nano()},
+		{"2024-02-01 12:00:00", DateTime()},
+		{"2024-02-01", DateOnly()},
+		// 純數字仍視為數字時間戳
+		{"20240201", UnixSeconds()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := conv.DetectFormat(tt.input)
+			if err != nil {
+				t.Fatalf("DetectFormat(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("DetectFormat(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatISO8601(t *testing.T) {
+	tests := []struct {
+		t       time.Time
+		week    string
+		ordinal string
+		basic   string
+	}{
+		{time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC), "2024-W05-4", "2024-032", "20240201T120000Z"},
+		{time.Date(2024, 12, 30, 8, 0, 0, 0, time.FixedZone("", 8*3600)), "2025-W01-1", "2024-365", "20241230T080000+0800"},
+		{time.Date(2021, 1, 3, 0, 0, 0, 500000000, time.UTC), "2020-W53-7", "2021-003", "20210103T000000.5Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.basic, func(t *testing.T) {
+			if got := FormatISOWeek(tt.t); got != tt.week {
+				t.Errorf("FormatISOWeek = %q, want %q", got, tt.week)
+			}
+			if got := FormatOrdinal(tt.t); got != tt.ordinal {
+				t.Errorf("FormatOrdinal = %q, want %q", got, tt.ordinal)
+			}
+			if got := FormatISO8601Basic(tt.t); got != tt.basic {
+				t.Errorf("FormatISO8601Basic = %q, want %q", got, tt.basic)
+			}
+		})
+	}
+}
+
+func TestConvertISOFields(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	result, err := conv.Convert("2024-W05-3T12:00Z", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.ISOWeek != "2024-W05-3" || result.Ordinal != "2024-031" || result.ISO8601Basic != "20240131T120000Z" {
+		t.Errorf("iso_week = %q, ordinal = %q, iso8601_basic = %q", result.ISOWeek, result.Ordinal, result.ISO8601Basic)
+	}
+}
@@ -0,0 +1,120 @@
+package converter
+
+import (
+	"time"
+)
+
+const (
+	// nanosPerDay 一天的奈秒數，用於將小數天數換算為時間
+	nanosPerDay = int64(24 * time.Hour)
+
+	// unixEpochJD Unix 紀元 (1970-01-01 00:00 UTC) 的儒略日
+	unixEpochJD = 2440587.5
+
+	// unixEpochMJD Unix 紀元的修正儒略日 (MJD = JD - 2400000.5)
+	unixEpochMJD = 40587
+
+	// excelLeapBugSerial Excel 1900 日期系統中不存在的 1900-02-29 序號
+	// Excel 沿用 Lotus 1-2-3 將 1900 年視為閏年，此序號之後的日期都多算一天
+	excelLeapBugSerial = 60
+)
+
+// ToJulianDay 將時間轉為儒略日 (Julian Day)，以 UTC 計算
+func ToJulianDay(t time.Time) float64 {
+	return unixEpochJD + unixDays(t)
+}
+
+// ToModifiedJulianDay 將時間轉為修正儒略日 (Modified Julian Day)，以 UTC 計算
+func ToModifiedJulianDay(t time.Time) float64 {
+	return unixEpochMJD + unixDays(t)
+}
+
+// unixDays 自 Unix 紀元起的天數 (含小數)
+func unixDays(t time.Time) float64 {
+	days := floorDivInt64(t.Unix(), 86400)
+	rem := t.Unix() - days*86400
+	return float64(days) + (float64(rem)+float64(t.Nanosecond())/1e9)/86400
+}
+
+// ToExcelSerial 將時間轉為 Excel 1900 日期系統的序號，以時間所在時區的牆上時間計算
+// 1900-03-01 之前的日期依 Excel 的行為少算一天，以對應 Lotus 的閏年錯誤
+func ToExcelSerial(t time.Time) float64 {
+	serial := excelDays(t, 1899, time.December, 30)
+	if serial < excelLeapBugSerial+1 {
+		serial--
+	}
+	return serial
+}
+
+// ToExcelSerial1904 將時間轉為 Excel 1904 日期系統的序號，以時間所在時區的牆上時間計算
+func ToExcelSerial1904(t time.Time) float64 {
+	return excelDays(t, 1904, time.January, 1)
+}
+
+// excelDays 計算牆上時間距離基準日的天數 (含小數)
+func excelDays(t time.Time, year int, month time.Month, day int) float64 {
+	base := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	days := civilDays(t) - civilDays(base)
+	clock := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+	return float64(days) + float64(clock)/float64(nanosPerDay)
+}
+
+// floorDivInt64 向下取整的整數除法
+func floorDivInt64(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// parseDayNumber 解析儒略日或修正儒略日
+// epochDays 為 Unix 紀元對應的整數日數，halfDay 表示日數從正午起算
+func (c *Converter) parseDayNumber(input string, epochDays int64, halfDay bool) (time.Time, error) {
+	days, nanos, err := parseDecimal(input, nanosPerDay)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// 儒略日從正午開始，先加半天再換算
+	if halfDay {
+		nanos += nanosPerDay / 2
+	}
+	secs := (days-epochDays)*86400 + nanos/int64(time.Second)
+	return time.Unix(secs, nanos%int64(time.Second)).In(c.Location), nil
+}
+
+// parseJulianDay 解析儒略日
+func (c *Converter) parseJulianDay(input string) (time.Time, error) {
+	// unixEpochJD = 2440587.5，以整數天數 2440588 搭配正午起算處理
+	return c.parseDayNumber(input, 2440588, true)
+}
+
+// parseModifiedJulianDay 解析修正儒略日
+func (c *Converter) parseModifiedJulianDay(input string) (time.Time, error) {
+	return c.parseDayNumber(input, unixEpochMJD, false)
+}
+
+// parseExcelSerial 解析 Excel 1900 日期系統的序號，以 Converter.Location 的牆上時間解讀
+func (c *Converter) parseExcelSerial(input string) (time.Time, error) {
+	days, nanos, err := parseDecimal(input, nanosPerDay)
+	if err != nil {
+		return time.Time{}, err
+	}
+	switch {
+	case days == excelLeapBugSerial:
+		return time.Time{}, &InputError{Kind: ErrInvalidDate, Type: "Excel", Value: "1900-02-29", Position: -1}
+	case days < excelLeapBugSerial:
+		return time.Date(1899, time.December, 31+int(days), 0, 0, 0, int(nanos), c.Location), nil
+	}
+	return time.Date(1899, time.December, 30+int(days), 0, 0, 0, int(nanos), c.Location), nil
+}
+
+// parseExcelSerial1904 解析 Excel 1904 日期系統的序號，以 Converter.Location 的牆上時間解讀
+func (c *Converter) parseExcelSerial1904(input string) (time.Time, error) {
+	days, nanos, err := parseDecimal(input, nanosPerDay)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(1904, time.January, 1+int(days), 0, 0, 0, int(nanos), c.Location), nil
+}
@@ -0,0 +1,109 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestParseDayNumbers(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		name   string
+		input  string
+		format TimestampFormat
+		want   time.Time
+	}{
+		{"J2000", "2451545.0", JulianDay(), time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{"JD midnight", "2451544.5", JulianDay(), time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"JD unix epoch", "2440587.5", JulianDay(), time.Unix(0, 0)},
+		{"JD sub-second", "2451545.000011574074", JulianDay(), time.Date(2000, 1, 1, 12, 0, 0, 999999994, time.UTC)},
+		{"MJD zero", "0", ModifiedJulianDay(), time.Date(1858, 11, 17, 0, 0, 0, 0, time.UTC)},
+		{"MJD fractional", "59600.75", ModifiedJulianDay(), time.Date(2022, 1, 21, 18, 0, 0, 0, time.UTC)},
+		{"MJD negative", "-0.25", ModifiedJulianDay(), time.Date(1858, 11, 16, 18, 0, 0, 0, time.UTC)},
+		{"Excel 1900-01-01", "1", ExcelSerial(), time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"Excel 1900-02-28", "59", ExcelSerial(), time.Date(1900, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"Excel 1900-03-01", "61", ExcelSerial(), time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"Excel 2022", "44582.5", ExcelSerial(), time.Date(2022, 1, 21, 12, 0, 0, 0, time.UTC)},
+		{"Excel half second", "44582.000005787037037037", ExcelSerial(), time.Date(2022, 1, 21, 0, 0, 0, 500000000, time.UTC)},
+		{"Excel 1904 epoch", "0", ExcelSerial1904(), time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"Excel 1904", "43120.25", ExcelSerial1904(), time.Date(2022, 1, 21, 6, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := conv.Parse(tt.input, tt.format)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseExcelUsesWallClock(t *testing.T) {
+	conv, _ := NewConverter("Asia/Taipei")
+
+	got, err := conv.Parse("44582.5", ExcelSerial())
+	if err != nil {
+		t.Fatalf("Parse error = %v", err)
+	}
+	if got.Format(time.RFC3339) != "2022-01-21T12:00:00+08:00" {
+		t.Errorf("Parse(44582.5) = %s", got.Format(time.RFC3339))
+	}
+	if serial := ToExcelSerial(got); serial != 44582.5 {
+		t.Errorf("ToExcelSerial = %v, want 44582.5", serial)
+	}
+}
+
+func TestParseExcelLeapBug(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	if _, err := conv.Parse("60", ExcelSerial()); err == nil {
+		t.Error("Parse(60, ExcelSerial) expected error for 1900-02-29")
+	}
+	if _, err := conv.Parse("abc", JulianDay()); err == nil {
+		t.Error("Parse(abc, JulianDay) expected error")
+	}
+}
+
+func TestDayNumberOutputs(t *testing.T) {
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"JD J2000", ToJulianDay(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)), 2451545},
+		{"MJD", ToModifiedJulianDay(time.Date(2022, 1, 21, 18, 0, 0, 0, time.UTC)), 59600.75},
+		{"MJD before 1970", ToModifiedJulianDay(time.Date(1858, 11, 16, 18, 0, 0, 0, time.UTC)), -0.25},
+		{"Excel 1900-02-28", ToExcelSerial(time.Date(1900, 2, 28, 12, 0, 0, 0, time.UTC)), 59.5},
+		{"Excel 1900-03-01", ToExcelSerial(time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC)), 61},
+		{"Excel 1904", ToExcelSerial1904(time.Date(2022, 1, 21, 6, 0, 0, 0, time.UTC)), 43120.25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if math.Abs(tt.got-tt.want) > 1e-9 {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertDayNumberFields(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	result, err := conv.Convert("2022-01-21 12:00:00", nil)
+	if err != nil {
+		t.Fatalf("Convert failed: %v", err)
+	}
+	if result.JulianDay != 2459601 || result.ModifiedJulianDay != 59600.5 ||
+		result.ExcelSerial != 44582.5 || result.ExcelSerial1904 != 43120.5 {
+		t.Errorf("JD/MJD/Excel/Excel1904 = %v/%v/%v/%v",
+			result.JulianDay, result.ModifiedJulianDay, result.ExcelSerial, result.ExcelSerial1904)
+	}
+}
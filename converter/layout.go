@@ -0,0 +1,153 @@
+package converter
+
+import (
+	"strings"
+	"time"
+)
+
+// LayoutFormat 建立使用 Go 參考版面 (如 "2006-01-02 15:04") 的自訂輸入格式
+func LayoutFormat(layout string) (TimestampFormat, error) {
+	if !hasLayoutElements(layout) {
+		return TimestampFormat{}, &InputError{Kind: ErrInvalidLayout, Type: "Go", Value: layout, Position: -1}
+	}
+	return TimestampFormat{layout: layout, pattern: layout, kind: "layout"}, nil
+}
+
+// StrftimeFormat 建立使用 strftime 樣式 (如 "%Y/%m/%d %H:%M") 的自訂輸入格式
+func StrftimeFormat(pattern string) (TimestampFormat, error) {
+	layout, err := strftimeToLayout(pattern)
+	if err != nil {
+		return TimestampFormat{}, err
+	}
+	return TimestampFormat{layout: layout, pattern: pattern, kind: "strftime"}, nil
+}
+
+// Layout 回傳自訂格式的 Go 參考版面，內建格式回傳空字串
+func (f TimestampFormat) Layout() string {
+	return f.layout
+}
+
+// Pattern 回傳自訂格式的原始樣式與種類 ("layout" 或 "strftime")，內建格式回傳兩個空字串
+func (f TimestampFormat) Pattern() (string, string) {
+	return f.pattern, f.kind
+}
+
+// IsCustom 判斷是否為自訂版面格式
+func (f TimestampFormat) IsCustom() bool {
+	return f.layout != ""
+}
+
+// parseCustom 以自訂版面解析輸入，版面不含時區時使用 Converter.Location
+func (c *Converter) parseCustom(input string, format TimestampFormat) (time.Time, error) {
+	t, err := time.ParseInLocation(format.layout, input, c.Location)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.In(c.Location), nil
+}
+
+// layoutProbe 用於偵測版面元素的時間，每個欄位都與參考時間不同
+var layoutProbe = time.Date(1999, 11, 28, 22, 33, 44, 987654321, time.FixedZone("PRB", 3*3600+30*60))
+
+// hasLayoutElements 判斷字串是否包含 Go 參考版面的時間元素
+func hasLayoutElements(layout string) bool {
+	return layout != "" && layoutProbe.Format(layout) != layout
+}
+
+// strftimeLayouts strftime 指令與 Go 參考版面的對應 (僅限可解析的指令)
+var strftimeLayouts = map[byte]string{
+	'a': "Mon",
+	'A': "Monday",
+	'b': "Jan",
+	'h': "Jan",
+	'B': "January",
+	'd': "02",
+	'e': "_2",
+	'j': "002",
+	'm': "01",
+	'y': "06",
+	'Y': "2006",
+	'H': "15",
+	'I': "03",
+	'M': "04",
+	'S': "05",
+	'p': "PM",
+	'z': "-0700",
+	'Z': "MST",
+	'F': "2006-01-02",
+	'T': "15:04:05",
+	'R': "15:04",
+	'D': "01/02/06",
+}
+
+// strftimeToLayout 將 strftime 樣式轉為 Go 參考版面
+// %N 需緊接在 "." 或 "," 之後 (如 %S.%N)，對應 Go 的小數秒
+func strftimeToLayout(pattern string) (string, error) {
+	if pattern == "" {
+		return "", &InputError{Kind: ErrInvalidLayout, Type: "strftime", Value: pattern, Position: -1}
+	}
+
+	var sb strings.Builder
+	literalStart := -1
+	flushLiteral := func(end int) error {
+		if literalStart < 0 {
+			return nil
+		}
+		start, literal := literalStart, pattern[literalStart:end]
+		literalStart = -1
+		// Go 版面無法跳脫，字面文字若包含版面元素會被誤解析
+		if hasLayoutElements(literal) {
+			return &InputError{Kind: ErrInvalidLayout, Type: "strftime", Value: literal, Position: start}
+		}
+		sb.WriteString(literal)
+		return nil
+	}
+
+	hasDirective := false
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] != '%' {
+			if literalStart < 0 {
+				literalStart = i
+			}
+			continue
+		}
+		if err := flushLiteral(i); err != nil {
+			return "", err
+		}
+		if i+1 >= len(pattern) {
+			return "", &InputError{Kind: ErrUnsupportedDirective, Type: "strftime", Value: "%", Position: i}
+		}
+		i++
+
+		switch d := pattern[i]; {
+		case d == '%':
+			sb.WriteByte('%')
+		case d == 'N':
+			s := sb.String()
+			if !strings.HasSuffix(s, ".") && !strings.HasSuffix(s, ",") {
+				return "", &InputError{Kind: ErrUnsupportedDirective, Type: "strftime", Value: "%N", Position: i - 1}
+			}
+			sb.WriteString("999999999")
+			hasDirective = true
+		case d == ':' && i+1 < len(pattern) && pattern[i+1] == 'z':
+			i++
+			sb.WriteString("-07:00")
+			hasDirective = true
+		default:
+			layout, ok := strftimeLayouts[d]
+			if !ok {
+				return "", &InputError{Kind: ErrUnsupportedDirective, Type: "strftime", Value: "%" + string(d), Position: i - 1}
+			}
+			sb.WriteString(layout)
+			hasDirective = true
+		}
+	}
+	if err := flushLiteral(len(pattern)); err != nil {
+		return "", err
+	}
+	if !hasDirective {
+		return "", &InputError{Kind: ErrInvalidLayout, Type: "strftime", Value: pattern, Position: -1}
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,125 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLayoutFormat(t *testing.T) {
+	conv, _ := NewConverter("Asia/Taipei")
+	taipei := conv.Location
+
+	tests := []struct {
+		name    string
+		layout  string
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"date only uses converter location", "2006-01-02", "2022-01-01", time.Date(2022, 1, 1, 0, 0, 0, 0, taipei), false},
+		{"custom separators", "02/01/2006 15:04", "21/01/2022 12:30", time.Date(2022, 1, 21, 12, 30, 0, 0, taipei), false},
+		{"layout with zone keeps zone", "2006-01-02 15:04 -0700", "2022-01-21 12:00 +0000", time.Date(2022, 1, 21, 12, 0, 0, 0, time.UTC), false},
+		{"mismatched input", "2006-01-02", "01/21/2022", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := LayoutFormat(tt.layout)
+			if err != nil {
+				t.Fatalf("LayoutFormat(%q) error = %v", tt.layout, err)
+			}
+			if format.Layout() != tt.layout {
+				t.Errorf("Layout() = %q, want %q", format.Layout(), tt.layout)
+			}
+			got, err := conv.Parse(tt.input, format)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := LayoutFormat("no elements"); err == nil {
+		t.Error("LayoutFormat without layout elements expected error")
+	}
+}
+
+func TestLayoutFormatValue(t *testing.T) {
+	a, _ := LayoutFormat("2006/01/02")
+	b, _ := LayoutFormat("2006/01/02")
+	c, _ := LayoutFormat("2006.01.02")
+	if a != b {
+		t.Errorf("same layout compares unequal: %v != %v", a, b)
+	}
+	if a == c {
+		t.Errorf("different layouts compare equal: %v", a)
+	}
+	if !a.IsCustom() || UnixSeconds().IsCustom() || (TimestampFormat{}).IsCustom() {
+		t.Error("IsCustom() mismatch")
+	}
+	if a == UnixSeconds() || (TimestampFormat{}) == UnixSeconds() {
+		t.Error("custom or zero format equals a built-in format")
+	}
+	if UnixSeconds().Layout() != "" {
+		t.Errorf("built-in format Layout() = %q, want empty", UnixSeconds().Layout())
+	}
+
+	s, _ := StrftimeFormat("%Y/%m/%d")
+	if pattern, kind := s.Pattern(); pattern != "%Y/%m/%d" || kind != "strftime" {
+		t.Errorf("Pattern() = %q, %q, want strftime pattern", pattern, kind)
+	}
+	if pattern, kind := a.Pattern(); pattern != "2006/01/02" || kind != "layout" {
+		t.Errorf("Pattern() = %q, %q, want Go layout", pattern, kind)
+	}
+	if pattern, kind := UnixSeconds().Pattern(); pattern != "" || kind != "" {
+		t.Errorf("built-in format Pattern() = %q, %q, want empty", pattern, kind)
+	}
+}
+
+func TestStrftimeFormat(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		name    string
+		pattern string
+		input   string
+		want    time.Time
+	}{
+		{"slashed date time", "%Y/%m/%d %H:%M", "2022/01/21 12:30", time.Date(2022, 1, 21, 12, 30, 0, 0, time.UTC)},
+		{"month names", "%d %b %Y", "21 Jan 2022", time.Date(2022, 1, 21, 0, 0, 0, 0, time.UTC)},
+		{"composites and zone", "%F %T %z", "2022-01-21 12:00:00 +0800", time.Date(2022, 1, 21, 4, 0, 0, 0, time.UTC)},
+		{"fractional seconds", "%H:%M:%S.%N %Y-%m-%d", "12:00:00.250 2022-01-21", time.Date(2022, 1, 21, 12, 0, 0, 250000000, time.UTC)},
+		{"day of year", "%Y-%j", "2022-032", time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"12 hour clock", "%Y-%m-%d %I:%M %p", "2022-01-21 03:15 PM", time.Date(2022, 1, 21, 15, 15, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := StrftimeFormat(tt.pattern)
+			if err != nil {
+				t.Fatalf("StrftimeFormat(%q) error = %v", tt.pattern, err)
+			}
+			result, err := conv.Convert(tt.input, &format)
+			if err != nil {
+				t.Fatalf("Convert(%q) error = %v", tt.input, err)
+			}
+			if !result.Time.Equal(tt.want) {
+				t.Errorf("Convert(%q) = %v, want %v", tt.input, result.Time, tt.want)
+			}
+			if result.DetectedFormat != "strftime pattern ("+tt.pattern+")" {
+				t.Errorf("DetectedFormat = %q", result.DetectedFormat)
+			}
+		})
+	}
+}
+
+func TestStrftimeFormatInvalid(t *testing.T) {
+	for _, pattern := range []string{"", "plain text", "%Q", "%s", "%N", "%Y 2006", "%Y%"} {
+		if _, err := StrftimeFormat(pattern); err == nil {
+			t.Errorf("StrftimeFormat(%q) expected error", pattern)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package converter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// yearlessFutureTolerance 推算年份時允許晚於參考時間的幅度，容許主機間的時鐘誤差與時區差異
+const yearlessFutureTolerance = 48 * time.Hour
+
+var (
+	// syslogPattern RFC 3164 syslog 時間，不含年份，如 "Jan  2 15:04:05"，可帶小數秒
+	syslogPattern = regexp.MustCompile(`^(?:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec) +\d{1,2} \d{2}:\d{2}:\d{2}(?:\.\d+)?$`)
+
+	// clfPattern Apache/Nginx 通用日誌格式 (CLF) 的時間，如 "[02/Jan/2006:15:04:05 -0700]"，方括號可省略
+	clfPattern = regexp.MustCompile(`^\[?\d{2}/(?:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)/\d{4}:\d{2}:\d{2}:\d{2} [+-]\d{4}\]?$`)
+
+	// logDateTimePattern Nginx 錯誤日誌與 Go log 套件的時間，如 "2006/01/02 15:04:05"，可帶小數秒
+	logDateTimePattern = regexp.MustCompile(`^\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2}(?:\.\d+)?$`)
+
+	// klogPattern Kubernetes klog/glog 標頭的嚴重性與時間，不含年份，如 "I0102 15:04:05.000000"
+	klogPattern = regexp.MustCompile(`^[IWEF](\d{4} \d{2}:\d{2}:\d{2}(?:\.\d+)?)$`)
+)
+
+// detectLogFormat 偵測 syslog、CLF、Nginx 錯誤日誌與 klog 的時間
+func detectLogFormat(input string) (TimestampFormat, bool) {
+	switch {
+	case syslogPattern.MatchString(input):
+		return Syslog(), true
+	case clfPattern.MatchString(input):
+		return CLF(), true
+	case logDateTimePattern.MatchString(input):
+		return LogDateTime(), true
+	case klogPattern.MatchString(input):
+		return Klog(), true
+	}
+	return TimestampFormat{}, false
+}
+
+// parseSyslog 解析 RFC 3164 syslog 時間，年份依參考時間推算
+func (c *Converter) parseSyslog(input string) (time.Time, error) {
+	// 日期不足兩位時 syslog 以空白補齊 (如 "Jan  2")，統一為單一空白後解析
+	t, err := time.Parse("Jan 2 15:04:05", strings.Join(strings.Fields(input), " "))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return c.inferYear(t)
+}
+
+// parseKlog 解析 klog/glog 標頭的時間 (如 "I0102 15:04:05.000000")，年份依參考時間推算
+func (c *Converter) parseKlog(input string) (time.Time, error) {
+	m := klogPattern.FindStringSubmatch(input)
+	if m == nil {
+		return time.Time{}, &InputError{Kind: ErrPatternMismatch, Type: "klog", Value: input, Position: -1}
+	}
+	t, err := time.Parse("0102 15:04:05", m[1])
+	if err != nil {
+		return time.Time{}, err
+	}
+	return c.inferYear(t)
+}
+
+// parseCLF 解析 Apache/Nginx 通用日誌格式的時間，允許外圍的方括號
+func (c *Converter) parseCLF(input string) (time.Time, error) {
+	input = strings.TrimSuffix(strings.TrimPrefix(input, "["), "]")
+	t, err := time.Parse("02/Jan/2006:15:04:05 -0700", input)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.In(c.Location), nil
+}
+
+// inferYear 為不含年份的日誌時間 parsed 補上年份，並以 Converter.Location 解讀
+// 日誌記錄的是過去的事件，因此取不晚於參考時間加上 yearlessFutureTolerance 的最近一年；
+// 跨年時 (如 1 月初讀取 12 月底的日誌) 會回到前一年，2 月 29 日則回溯至最近的閏年
+func (c *Converter) inferYear(parsed time.Time) (time.Time, error) {
+	ref := c.now()
+	limit := ref.Add(yearlessFutureTolerance)
+	_, month, day := parsed.Date()
+	hour, min, sec := parsed.Clock()
+
+	for year := ref.Year() + 1; year >= ref.Year()-8; year-- {
+		t := time.Date(year, month, day, hour, min, sec, parsed.Nanosecond(), c.Location)
+		if t.Day() == day && !t.After(limit) {
+			return t, nil
+		}
+	}
+	return time.Time{}, inputError(ErrYearInference, fmt.Sprintf("%02d-%02d", int(month), day))
+}
@@ -0,0 +1,119 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"testing"
+	"time"
+)
+
+// newLogConverter 建立參考時間固定的轉換器
+func newLogConverter(ref time.Time) *Converter {
+	conv, _ := NewConverter("UTC")
+	conv.Now = func() time.Time { return ref }
+	return conv
+}
+
+func TestDetectLogFormats(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		input string
+		want  TimestampFormat
+	}{
+		{"Jan  2 15:04:05", Syslog()},
+		{"Jan 12 15:04:05", Syslog()},
+		{"Jan 12 15:04:05.123456", Syslog()},
+		{"02/Jan/2006:15:04:05 -0700", CLF()},
+		{"[02/Jan/2006:15:04:05 +0000]", CLF()},
+		{"2006/01/02 15:04:05", LogDateTime()},
+		{"2006/01/02 15:04:05.000123", LogDateTime()},
+		{"I0102 15:04:05.000000", Klog()},
+		{"E1231 23:59:59.999999", Klog()},
+		// RFC 5424 syslog 的時間即為 RFC 3339
+		{"2006-01-02T15:04:05.000000+00:00", RFC3339Nano()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := conv.DetectFormat(tt.input)
+			if err != nil {
+				t.Fatalf("DetectFormat(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("DetectFormat(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLogFormats(t *testing.T) {
+	ref := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		input  string
+		format TimestampFormat
+		want   time.Time
+	}{
+		{"syslog this year", "Jan  2 15:04:05", Syslog(), time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"syslog fraction", "Mar 15 09:59:59.25", Syslog(), time.Date(2024, 3, 15, 9, 59, 59, 250000000, time.UTC)},
+		{"syslog later this year is last year", "Dec 31 23:59:59", Syslog(), time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC)},
+		{"syslog within clock skew", "Mar 16 09:00:00", Syslog(), time.Date(2024, 3, 16, 9, 0, 0, 0, time.UTC)},
+		{"syslog beyond clock skew", "Mar 18 09:00:00", Syslog(), time.Date(2023, 3, 18, 9, 0, 0, 0, time.UTC)},
+		{"syslog leap day", "Feb 29 12:00:00", Syslog(), time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)},
+		{"klog", "I0102 15:04:05.000001", Klog(), time.Date(2024, 1, 2, 15, 4, 5, 1000, time.UTC)},
+		{"klog last year", "W1224 08:00:00.000000", Klog(), time.Date(2023, 12, 24, 8, 0, 0, 0, time.UTC)},
+		{"clf", "02/Jan/2006:15:04:05 -0700", CLF(), time.Date(2006, 1, 2, 22, 4, 5, 0, time.UTC)},
+		{"clf brackets", "[02/Jan/2006:15:04:05 +0000]", CLF(), time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"nginx error log", "2006/01/02 15:04:05", LogDateTime(), time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"go log microseconds", "2006/01/02 15:04:05.000123", LogDateTime(), time.Date(2006, 1, 2, 15, 4, 5, 123000, time.UTC)},
+	}
+
+	conv := newLogConverter(ref)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := conv.Parse(tt.input, tt.format)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse(%q) = %s, want %s", tt.input, got.Format(time.RFC3339Nano), tt.want.Format(time.RFC3339Nano))
+			}
+		})
+	}
+}
+
+func TestInferYearAcrossNewYear(t *testing.T) {
+	// 12 月 31 日深夜讀取來自時鐘略快主機的 1 月 1 日日誌
+	conv := newLogConverter(time.Date(2023, 12, 31, 23, 59, 0, 0, time.UTC))
+	got, err := conv.Parse("Jan  1 00:00:30", Syslog())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Parse = %s, want %s", got, want)
+	}
+
+	// 非閏年讀取 2 月 29 日回溯至最近的閏年
+	conv = newLogConverter(time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC))
+	got, err = conv.Parse("Feb 29 12:00:00", Syslog())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2020, 2, 29, 12, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Parse(Feb 29) = %s, want %s", got, want)
+	}
+}
+
+func TestParseLogFormatsInLocation(t *testing.T) {
+	conv, _ := NewConverter("Asia/Taipei")
+	conv.Now = func() time.Time { return time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC) }
+
+	got, err := conv.Parse("Jan  2 15:04:05", Syslog())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 1, 2, 7, 4, 5, 0, time.UTC); !got.Equal(want) || got.Location() != conv.Location {
+		t.Errorf("Parse = %s, want %s in Asia/Taipei", got, want)
+	}
+}
@@ -0,0 +1,325 @@
+package converter
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 自然語言時間的正則表達式
+var (
+	naturalSpaces    = regexp.MustCompile(`\s+`)
+	naturalClock     = regexp.MustCompile(`^(?:(?:.*)\s)?(?:at\s)?(noon|midnight|\d{1,2}(?::\d{2}(?::\d{2})?)?\s?(?:am|pm)|\d{1,2}:\d{2}(?::\d{2})?)$`)
+	naturalAgo       = regexp.MustCompile(`^(\S+) ([a-z]+) (ago|from now|later|before|after)$`)
+	naturalIn        = regexp.MustCompile(`^in (\S+) ([a-z]+)$`)
+	naturalWeekday   = regexp.MustCompile(`^(?:(next|last|this) )?([a-z]+)$`)
+	naturalBoundary  = regexp.MustCompile(`^(start|beginning|end) of (?:the )?(?:(this|next|last|previous) )?([a-z]+)$`)
+	naturalDayOf     = regexp.MustCompile(`^(first|last) day of (?:the )?(?:(this|next|last|previous) )?([a-z]+)$`)
+	naturalClockSpec = regexp.MustCompile(`^(\d{1,2})(?::(\d{2})(?::(\d{2}))?)?\s?(am|pm)?$`)
+)
+
+// naturalNumbers 以英文書寫的數字
+var naturalNumbers = map[string]int{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+}
+
+// naturalWeekdays 星期名稱 (含縮寫)
+var naturalWeekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// naturalUnit 將英文時間單位正規化為 s/m/h/d/w/M/q/y
+func naturalUnit(word string) (string, bool) {
+	switch strings.TrimSuffix(word, "s") {
+	case "second", "sec":
+		return "s", true
+	case "minute", "min":
+		return "m", true
+	case "hour", "hr":
+		return "h", true
+	case "day":
+		return "d", true
+	case "week", "wk":
+		return "w", true
+	case "fortnight":
+		return "f", true
+	case "month":
+		return "M", true
+	case "quarter":
+		return "q", true
+	case "year", "yr":
+		return "y", true
+	}
+	return "", false
+}
+
+// ParseNatural 解析英文自然語言的相對時間，以 ref 作為參考時間
+//
+// 支援的寫法:
+//
+//	now, today, yesterday, tomorrow (可加上時刻，如 "tomorrow noon")
+//	"3 days ago", "in 2 weeks", "an hour from now"
+//	"monday", "next friday 9am", "last tuesday at 18:30"
+//	"next week", "last month", "this year"
+//	"start of quarter", "end of next month", "beginning of the week"
+//	"first day of month", "last day of next year"
+//
+// 日期類的寫法 (yesterday, next monday) 未指定時刻時保留參考時間的時刻；
+// start/end/first/last 類的寫法則對齊到該天的開始或結束。週一為一週的第一天。
+func (c *Converter) ParseNatural(input string, ref time.Time) (time.Time, error) {
+	phrase := strings.ToLower(strings.TrimSpace(input))
+	phrase = naturalSpaces.ReplaceAllString(phrase, " ")
+	if phrase == "" {
+		return time.Time{}, inputError(ErrUnknownPhrase, input)
+	}
+
+	ref = ref.In(c.Location)
+
+	// 先拆出結尾的時刻 (如 "9am", "09:00", "noon")
+	var clock *[3]int
+	if m := naturalClock.FindStringSubmatchIndex(phrase); m != nil {
+		spec := phrase[m[2]:m[3]]
+		hms, err := parseNaturalClock(spec)
+		if err != nil {
+			return time.Time{}, err
+		}
+		clock = &hms
+		phrase = strings.TrimSpace(phrase[:m[2]])
+		if phrase == "at" {
+			phrase = ""
+		}
+		phrase = strings.TrimSuffix(phrase, " at")
+	}
+
+	t, err := c.parseNaturalDate(phrase, ref)
+	if err != nil {
+		return time.Time{}, inputError(ErrUnknownPhrase, input)
+	}
+
+	if clock != nil {
+		t = time.Date(t.Year(), t.Month(), t.Day(), clock[0], clock[1], clock[2], 0, c.Location)
+	}
+	return t, nil
+}
+
+// parseNaturalDate 解析不含時刻的自然語言日期部分
+func (c *Converter) parseNaturalDate(phrase string, ref time.Time) (time.Time, error) {
+	switch phrase {
+	case "", "now", "today":
+		return ref, nil
+	case "yesterday":
+		return ref.AddDate(0, 0, -1), nil
+	case "tomorrow":
+		return ref.AddDate(0, 0, 1), nil
+	}
+
+	if m := naturalAgo.FindStringSubmatch(phrase); m != nil {
+		sign := 1
+		if m[3] == "ago" || m[3] == "before" {
+			sign = -1
+		}
+		return addNaturalUnits(ref, m[1], m[2], sign)
+	}
+
+	if m := naturalIn.FindStringSubmatch(phrase); m != nil {
+		return addNaturalUnits(ref, m[1], m[2], 1)
+	}
+
+	if m := naturalBoundary.FindStringSubmatch(phrase); m != nil {
+		base, unit, err := shiftNaturalPeriod(ref, m[2], m[3])
+		if err != nil {
+			return time.Time{}, err
+		}
+		start := startOfPeriod(base, unit)
+		if m[1] == "end" {
+			return endOfPeriod(start, unit), nil
+		}
+		return start, nil
+	}
+
+	if m := naturalDayOf.FindStringSubmatch(phrase); m != nil {
+		base, unit, err := shiftNaturalPeriod(ref, m[2], m[3])
+		if err != nil {
+			return time.Time{}, err
+		}
+		start := startOfPeriod(base, unit)
+		if m[1] == "last" {
+			end := endOfPeriod(start, unit)
+			return time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location()), nil
+		}
+		return start, nil
+	}
+
+	if m := naturalWeekday.FindStringSubmatch(phrase); m != nil {
+		if weekday, ok := naturalWeekdays[m[2]]; ok {
+			return shiftToWeekday(ref, weekday, m[1]), nil
+		}
+		if m[1] != "" {
+			base, _, err := shiftNaturalPeriod(ref, m[1], m[2])
+			return base, err
+		}
+	}
+
+	return time.Time{}, inputError(ErrUnknownPhrase, phrase)
+}
+
+// parseNaturalClock 解析時刻，回傳時、分、秒
+func parseNaturalClock(spec string) ([3]int, error) {
+	switch spec {
+	case "noon":
+		return [3]int{12, 0, 0}, nil
+	case "midnight":
+		return [3]int{0, 0, 0}, nil
+	}
+
+	m := naturalClockSpec.FindStringSubmatch(spec)
+	if m == nil {
+		return [3]int{}, inputError(ErrInvalidClock, spec)
+	}
+	hour, _ := strconv.Atoi(m[1])
+	minute, _ := strconv.Atoi(m[2])
+	second, _ := strconv.Atoi(m[3])
+
+	switch m[4] {
+	case "am", "pm":
+		if hour < 1 || hour > 12 {
+			return [3]int{}, inputError(ErrInvalidClock, spec)
+		}
+		hour %= 12
+		if m[4] == "pm" {
+			hour += 12
+		}
+	}
+	if hour > 23 || minute > 59 || second > 59 {
+		return [3]int{}, inputError(ErrInvalidClock, spec)
+	}
+	return [3]int{hour, minute, second}, nil
+}
+
+// addNaturalUnits 依英文數量與單位調整時間，日曆單位使用 AddDate
+func addNaturalUnits(ref time.Time, count, unitWord string, sign int) (time.Time, error) {
+	n, ok := naturalNumbers[count]
+	if !ok {
+		var err error
+		n, err = strconv.Atoi(count)
+		if err != nil {
+			return time.Time{}, inputError(ErrInvalidNumber, count)
+		}
+	}
+	unit, ok := naturalUnit(unitWord)
+	if !ok {
+		return time.Time{}, inputError(ErrUnsupportedUnit, unitWord)
+	}
+	return shiftByUnit(ref, unit, sign*n), nil
+}
+
+// shiftByUnit 以正規化的單位調整時間
+func shiftByUnit(t time.Time, unit string, n int) time.Time {
+	switch unit {
+	case "s":
+		return t.Add(time.Duration(n) * time.Second)
+	case "m":
+		return t.Add(time.Duration(n) * time.Minute)
+	case "h":
+		return t.Add(time.Duration(n) * time.Hour)
+	case "d":
+		return t.AddDate(0, 0, n)
+	case "w":
+		return t.AddDate(0, 0, 7*n)
+	case "f":
+		return t.AddDate(0, 0, 14*n)
+	case "M":
+		return t.AddDate(0, n, 0)
+	case "q":
+		return t.AddDate(0, 3*n, 0)
+	default: // y
+		return t.AddDate(n, 0, 0)
+	}
+}
+
+// shiftNaturalPeriod 依 this/next/last 移動一個週期，並回傳正規化的單位
+func shiftNaturalPeriod(ref time.Time, modifier, unitWord string) (time.Time, string, error) {
+	unit, ok := naturalUnit(unitWord)
+	if !ok || unit == "s" || unit == "m" || unit == "h" || unit == "f" {
+		return time.Time{}, "", inputError(ErrUnsupportedUnit, unitWord)
+	}
+
+	switch modifier {
+	case "next":
+		return shiftPeriodClamped(ref, unit, 1), unit, nil
+	case "last", "previous":
+		return shiftPeriodClamped(ref, unit, -1), unit, nil
+	}
+	return ref, unit, nil
+}
+
+// shiftPeriodClamped 移動 n 個週期；月、季、年超出月底時對齊到該月最後一天
+// 例如 1 月 31 日的 "next month" 為 2 月 28 日，而非溢位到 3 月
+func shiftPeriodClamped(t time.Time, unit string, n int) time.Time {
+	months := 0
+	switch unit {
+	case "M":
+		months = n
+	case "q":
+		months = 3 * n
+	case "y":
+		months = 12 * n
+	default:
+		return shiftByUnit(t, unit, n)
+	}
+
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// startOfPeriod 取得時間所在週期的開始
+func startOfPeriod(t time.Time, unit string) time.Time {
+	year, month, day := t.Date()
+	loc := t.Location()
+	switch unit {
+	case "w":
+		offset := (int(t.Weekday()) + 6) % 7 // 週一為一週的第一天
+		return time.Date(year, month, day-offset, 0, 0, 0, 0, loc)
+	case "M":
+		return time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	case "q":
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, loc)
+	case "y":
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	default: // d
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	}
+}
+
+// endOfPeriod 取得週期的最後一刻 (start 為週期開始)
+func endOfPeriod(start time.Time, unit string) time.Time {
+	return shiftByUnit(start, unit, 1).Add(-time.Nanosecond)
+}
+
+// shiftToWeekday 依 this/next/last 移動到指定的星期
+// 未加修飾或 this 時為今天或之後最近的一天，next 為之後的一天，last 為之前的一天
+func shiftToWeekday(ref time.Time, weekday time.Weekday, modifier string) time.Time {
+	diff := (int(weekday) - int(ref.Weekday()) + 7) % 7
+	switch modifier {
+	case "next":
+		if diff == 0 {
+			diff = 7
+		}
+	case "last":
+		diff -= 7
+	}
+	return ref.AddDate(0, 0, diff)
+}
@@ -0,0 +1,87 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseNatural(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+	// 2024-01-31 是星期三
+	ref := time.Date(2024, 1, 31, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input string
+		want  time.Time
+	}{
+		{"now", "now", ref},
+		{"yesterday keeps clock", "yesterday", time.Date(2024, 1, 30, 15, 30, 0, 0, time.UTC)},
+		{"tomorrow noon", "tomorrow noon", time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)},
+		{"today at midnight", "today at midnight", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+		{"bare clock", "9pm", time.Date(2024, 1, 31, 21, 0, 0, 0, time.UTC)},
+		{"days ago", "3 days ago", time.Date(2024, 1, 28, 15, 30, 0, 0, time.UTC)},
+		{"in weeks", "in 2 weeks", time.Date(2024, 2, 14, 15, 30, 0, 0, time.UTC)},
+		{"an hour from now", "an hour from now", time.Date(2024, 1, 31, 16, 30, 0, 0, time.UTC)},
+		{"mixed case and spaces", "  In   5  Minutes ", time.Date(2024, 1, 31, 15, 35, 0, 0, time.UTC)},
+		{"months ago uses AddDate", "1 month ago", time.Date(2023, 12, 31, 15, 30, 0, 0, time.UTC)},
+		{"next monday with clock", "next monday 09:00", time.Date(2024, 2, 5, 9, 0, 0, 0, time.UTC)},
+		{"next wednesday skips today", "next wednesday", time.Date(2024, 2, 7, 15, 30, 0, 0, time.UTC)},
+		{"bare weekday is today", "wednesday", ref},
+		{"last friday at time", "last fri at 6:45pm", time.Date(2024, 1, 26, 18, 45, 0, 0, time.UTC)},
+		{"saturday abbreviation with clock", "sat 10am", time.Date(2024, 2, 3, 10, 0, 0, 0, time.UTC)},
+		{"next month clamps day", "next month", time.Date(2024, 2, 29, 15, 30, 0, 0, time.UTC)},
+		{"last year", "last year", time.Date(2023, 1, 31, 15, 30, 0, 0, time.UTC)},
+		{"last day of month", "last day of month", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+		{"last day of next month", "last day of next month", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"first day of the year", "first day of the year", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"start of quarter", "start of quarter", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"start of next quarter", "start of next quarter", time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"start of week is monday", "start of week", time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC)},
+		{"end of day", "end of day", time.Date(2024, 1, 31, 23, 59, 59, 999999999, time.UTC)},
+		{"end of last month", "end of last month", time.Date(2023, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := conv.ParseNatural(tt.input, ref)
+			if err != nil {
+				t.Fatalf("ParseNatural(%q) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseNatural(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseNaturalInvalid(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+	ref := time.Date(2024, 1, 31, 15, 30, 0, 0, time.UTC)
+
+	for _, input := range []string{"", "whenever", "3 lightyears ago", "next hour", "13pm", "tomorrow 25:00", "start of minute"} {
+		if _, err := conv.ParseNatural(input, ref); err == nil {
+			t.Errorf("ParseNatural(%q) expected error", input)
+		}
+	}
+}
+
+func TestConvertNaturalLanguage(t *testing.T) {
+	conv, _ := NewConverter("Asia/Taipei")
+	conv.Now = func() time.Time { return time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC) }
+
+	format, err := conv.DetectFormat("3 days ago")
+	if err != nil || format != NaturalLanguage() {
+		t.Fatalf("DetectFormat(\"3 days ago\") = %v, %v, want NaturalLanguage", format, err)
+	}
+
+	result, err := conv.Convert("tomorrow noon", nil)
+	if err != nil {
+		t.Fatalf("Convert failed: %v", err)
+	}
+	// 參考時間在台北為 2024-01-31 08:00，明天中午為 2024-02-01 12:00 (台北)
+	if result.DateTime != "2024-02-01 12:00:00" {
+		t.Errorf("DateTime = %q, want %q", result.DateTime, "2024-02-01 12:00:00")
+	}
+}
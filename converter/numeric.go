@@ -0,0 +1,118 @@
+package converter
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// decimalPattern 帶正負號與小數的數字
+var decimalPattern = regexp.MustCompile(`^[+-]?\d+(?:\.\d+)?$`)
+
+// maxAutoSeconds 位數不符合固定長度時，仍視為秒級時間戳的上限 (2100 年，負值為 1840 年)
+const maxAutoSeconds = 4102444800
+
+// detectNumeric 判斷數字時間戳的格式
+// 採用 DetectCandidates 中分數最高的解讀，沒有任何合理解讀時 (如 1970 年以前的負數) 改依位數判斷
+// Strict 模式下沒有唯一合理解讀時回傳 *AmbiguousError
+func (c *Converter) detectNumeric(input string) (TimestampFormat, error) {
+	candidates := c.DetectCandidates(input)
+	if c.Strict && Ambiguous(candidates) {
+		return TimestampFormat{}, &AmbiguousError{Input: input, Candidates: candidates}
+	}
+	if len(candidates) > 0 {
+		return candidates[0].Format, nil
+	}
+	return detectByLength(input)
+}
+
+// detectByLength 依整數部分的位數判斷 Unix 時間戳的精確度，正負號與小數點不計入位數
+func detectByLength(input string) (TimestampFormat, error) {
+	intPart, _, _ := strings.Cut(strings.TrimLeft(input, "+-"), ".")
+	num, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return TimestampFormat{}, &FormatError{Input: input, Numeric: true}
+	}
+
+	switch len(intPart) {
+	case 10:
+		return UnixSeconds(), nil
+	case 13:
+		return UnixMilliseconds(), nil
+	case 16:
+		return UnixMicroseconds(), nil
+	case 18, 19:
+		return UnixNanoseconds(), nil
+	default:
+		// 嘗試作為秒級時間戳 (1840–2100 年)，單獨的 0 不視為時間戳
+		if (num > 0 || !isPlainInteger(input)) && num < maxAutoSeconds {
+			return UnixSeconds(), nil
+		}
+		return TimestampFormat{}, &FormatError{Input: input, Numeric: true}
+	}
+}
+
+// isPlainInteger 判斷數字是否不帶正負號與小數
+func isPlainInteger(input string) bool {
+	return !strings.ContainsAny(input, "+-.")
+}
+
+// parseUnix 解析 Unix 時間戳，unit 為每單位的奈秒數
+// 接受正負號與小數 (如 "-86400"、"1640995200.123456")，小數部分精確四捨五入至奈秒
+func (c *Converter) parseUnix(input string, unit int64) (time.Time, error) {
+	whole, nanos, err := parseDecimal(input, unit)
+	if err != nil {
+		return time.Time{}, err
+	}
+	perSecond := int64(time.Second) / unit
+	secs := floorDivInt64(whole, perSecond)
+	return time.Unix(secs, (whole-secs*perSecond)*unit+nanos).In(c.Location), nil
+}
+
+// parseDecimal 將十進位數字拆為向下取整的整數部分與以 unit 為單位的小數部分
+// 例如 unit 為 1e9 時 "-1.25" 得到 -2 與 750000000；小數部分恆為非負
+// 整數與小數部分皆以字串精確計算，避免經過 float64 造成的精度損失
+func parseDecimal(input string, unit int64) (int64, int64, error) {
+	if !decimalPattern.MatchString(input) {
+		return 0, 0, inputError(ErrInvalidNumber, input)
+	}
+
+	negative := strings.HasPrefix(input, "-")
+	intPart, fracPart, _ := strings.Cut(strings.TrimLeft(input, "+-"), ".")
+	whole, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return 0, 0, inputError(ErrInvalidNumber, input)
+	}
+
+	frac := roundFraction(fracPart, unit)
+	if frac >= unit {
+		whole++
+		frac -= unit
+	}
+
+	if negative {
+		whole = -whole
+		if frac > 0 {
+			whole--
+			frac = unit - frac
+		}
+	}
+	return whole, frac, nil
+}
+
+// roundFraction 計算 0.<digits> × unit 並四捨五入為整數
+func roundFraction(digits string, unit int64) int64 {
+	if digits == "" {
+		return 0
+	}
+	n, _ := new(big.Int).SetString(digits, 10)
+	n.Mul(n, big.NewInt(unit))
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(digits))), nil)
+	q, r := n.QuoRem(n, scale, new(big.Int))
+	if r.Lsh(r, 1).Cmp(scale) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q.Int64()
+}
@@ -0,0 +1,100 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSignedAndFractionalEpochs(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		name   string
+		input  string
+		format TimestampFormat
+		want   time.Time
+	}{
+		{"1900 seconds", "-2208988800", UnixSeconds(), time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"1900 milliseconds", "-2208988800000", UnixMilliseconds(), time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"1900 microseconds", "-2208988800000000", UnixMicroseconds(), time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"1969 seconds", "-31536000", UnixSeconds(), time.Date(1969, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"1969 last second", "-1", UnixSeconds(), time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC)},
+		{"1969 milliseconds", "-1500", UnixMilliseconds(), time.Date(1969, 12, 31, 23, 59, 58, 500000000, time.UTC)},
+		{"1969 nanoseconds", "-1000000000000000000", UnixNanoseconds(), time.Date(1938, 4, 24, 22, 13, 20, 0, time.UTC)},
+		{"one day before epoch", "-86400", UnixSeconds(), time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"explicit plus sign", "+86400", UnixSeconds(), time.Date(1970, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"python time.time()", "1640995200.123456", UnixSeconds(), time.Date(2022, 1, 1, 0, 0, 0, 123456000, time.UTC)},
+		{"nanosecond digits", "1640995200.123456789", UnixSeconds(), time.Date(2022, 1, 1, 0, 0, 0, 123456789, time.UTC)},
+		{"rounds half up", "1640995200.0000000005", UnixSeconds(), time.Date(2022, 1, 1, 0, 0, 0, 1, time.UTC)},
+		{"rounds down", "1640995200.0000000004999", UnixSeconds(), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"rounds into next second", "1640995199.9999999999", UnixSeconds(), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"negative fraction", "-0.25", UnixSeconds(), time.Date(1969, 12, 31, 23, 59, 59, 750000000, time.UTC)},
+		{"negative fraction 1969", "-31535999.5", UnixSeconds(), time.Date(1969, 1, 1, 0, 0, 0, 500000000, time.UTC)},
+		{"fractional milliseconds", "1640995200123.456789", UnixMilliseconds(), time.Date(2022, 1, 1, 0, 0, 0, 123456789, time.UTC)},
+		{"fractional microseconds", "-1.5", UnixMicroseconds(), time.Date(1969, 12, 31, 23, 59, 59, 999998500, time.UTC)},
+		{"fractional nanoseconds", "1640995200000000000.5", UnixNanoseconds(), time.Date(2022, 1, 1, 0, 0, 0, 1, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := conv.Parse(tt.input, tt.format)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse(%q) = %s, want %s", tt.input, got.Format(time.RFC3339Nano), tt.want.Format(time.RFC3339Nano))
+			}
+		})
+	}
+}
+
+func TestDetectSignedAndFractionalEpochs(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		input string
+		want  TimestampFormat
+	}{
+		{"-86400", UnixSeconds()},
+		{"-1", UnixSeconds()},
+		{"-2208988800", UnixSeconds()},
+		{"-2208988800000", UnixMilliseconds()},
+		{"-2208988800000000", UnixMicroseconds()},
+		{"-100000000000000000", UnixNanoseconds()},
+		{"-1000000000000000000", UnixNanoseconds()},
+		{"+1640995200", UnixSeconds()},
+		{"1640995200.123456", UnixSeconds()},
+		{"1640995200123.5", UnixMilliseconds()},
+		{"1640995200123456.5", UnixMicroseconds()},
+		{"164099520000000000.5", UnixNanoseconds()},
+		{"13287254834000000.5", UnixNanoseconds()},
+		{"0.5", UnixSeconds()},
+		// 不帶正負號與小數的 18 位數字仍依範圍判斷為 FILETIME
+		{"132872548340000000", FileTime()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := conv.DetectFormat(tt.input)
+			if err != nil {
+				t.Fatalf("DetectFormat(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("DetectFormat(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectRejectsAmbiguousSignedNumbers(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	for _, input := range []string{"0", "-31536000000", "-13287254834000000", "-99999999999999999999"} {
+		t.Run(input, func(t *testing.T) {
+			if format, err := conv.DetectFormat(input); err == nil {
+				t.Errorf("DetectFormat(%q) = %v, want error", input, format)
+			}
+		})
+	}
+}
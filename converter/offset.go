@@ -0,0 +1,212 @@
+package converter
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Offset 解析後的時間偏移
+// 年、月、日使用 AddDate 的日曆語意，其餘部分為固定長度的 Duration
+type Offset struct {
+	Years    int
+	Months   int
+	Days     int
+	Duration time.Duration
+}
+
+// 偏移量語法的正則表達式
+var (
+	offsetComponent = regexp.MustCompile(`(\d+(?:\.\d+)?)([dwMyhms])`)
+	isoDuration     = regexp.MustCompile(`^P(?:(\d+(?:[.,]\d+)?)Y)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)W)?(?:(\d+(?:[.,]\d+)?)D)?(?:T(?:(\d+(?:[.,]\d+)?)H)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+)
+
+// ParseOffset 解析時間偏移
+//
+// 支援的格式:
+//
+//	單一或串接的數值與單位: 1d, -1d6h, +2w3d, 1.5h (單位: s, m, h, d, w, M, y)
+//	ISO 8601 期間: P1Y2M10DT2H30M, -PT15M, P2W
+//
+// 月與年必須為整數；天與週可為小數，小數部分以 24 小時換算
+// 串接格式中每個單位只能出現一次，累加結果超出 int 或 time.Duration 範圍時回傳 ErrOutOfRange
+func ParseOffset(offset string) (Offset, error) {
+	offset = strings.TrimSpace(offset)
+	if offset == "" {
+		return Offset{}, nil
+	}
+
+	// 檢查符號
+	sign := 1
+	body := offset
+	if strings.HasPrefix(body, "+") {
+		body = body[1:]
+	} else if strings.HasPrefix(body, "-") {
+		sign = -1
+		body = body[1:]
+	}
+
+	var o Offset
+	var err error
+	if strings.HasPrefix(body, "P") {
+		o, err = parseISODuration(body)
+	} else {
+		o, err = parseCompoundOffset(body)
+	}
+	if err != nil {
+		// 內部錯誤的位置相對於去除正負號的內容，換算為相對於整個偏移
+		var inputErr *InputError
+		if errors.As(err, &inputErr) && inputErr.Position >= 0 {
+			inputErr.Position += len(offset) - len(body)
+		}
+		return Offset{}, &OffsetError{Input: offset, Err: err}
+	}
+
+	if sign < 0 {
+		o = o.Negate()
+	}
+	return o, nil
+}
+
+// parseCompoundOffset 解析串接的數值與單位，如 1d6h30m
+func parseCompoundOffset(body string) (Offset, error) {
+	locs := offsetComponent.FindAllStringSubmatchIndex(body, -1)
+	if len(locs) == 0 {
+		return Offset{}, &InputError{Kind: ErrUnexpectedText, Value: body, Position: 0}
+	}
+
+	var o Offset
+	seen := make(map[string]bool)
+	pos := 0
+	for _, loc := range locs {
+		if loc[0] != pos {
+			return Offset{}, &InputError{Kind: ErrUnexpectedText, Value: body[pos:loc[0]], Position: pos}
+		}
+		pos = loc[1]
+		unit := body[loc[4]:loc[5]]
+		if seen[unit] {
+			return Offset{}, &InputError{Kind: ErrRepeatedUnit, Value: body[loc[0]:loc[1]], Position: loc[0]}
+		}
+		seen[unit] = true
+		if err := o.addComponent(body[loc[2]:loc[3]], unit); err != nil {
+			return Offset{}, err
+		}
+	}
+	if pos != len(body) {
+		return Offset{}, &InputError{Kind: ErrUnexpectedText, Value: body[pos:], Position: pos}
+	}
+	return o, nil
+}
+
+// parseISODuration 解析 ISO 8601 期間，如 P1Y2M10DT2H30M
+func parseISODuration(body string) (Offset, error) {
+	m := isoDuration.FindStringSubmatch(body)
+	if m == nil || body == "P" || strings.HasSuffix(body, "T") {
+		return Offset{}, &InputError{Kind: ErrPatternMismatch, Type: "ISO 8601", Value: body, Position: 0}
+	}
+
+	units := []string{"y", "M", "w", "d", "h", "m", "s"}
+	var o Offset
+	for i, unit := range units {
+		value := m[i+1]
+		if value == "" {
+			continue
+		}
+		if err := o.addComponent(strings.Replace(value, ",", ".", 1), unit); err != nil {
+			return Offset{}, err
+		}
+	}
+	return o, nil
+}
+
+// addComponent 累加單一數值與單位，結果超出範圍時回傳 ErrOutOfRange
+func (o *Offset) addComponent(value, unit string) error {
+	num, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return inputError(ErrInvalidNumber, value)
+	}
+	whole, frac := math.Modf(num)
+
+	// 年月日超出 int、其餘部分超出 time.Duration 時回報對應的型別
+	calendarOK, durationOK := true, true
+	switch unit {
+	case "y", "M":
+		if frac != 0 {
+			return inputError(ErrFractionalUnit, value+unit)
+		}
+		if unit == "y" {
+			calendarOK = addWhole(&o.Years, whole)
+		} else {
+			calendarOK = addWhole(&o.Months, whole)
+		}
+	case "w":
+		days, dayFrac := math.Modf(num * 7)
+		calendarOK = addWhole(&o.Days, days)
+		durationOK = addFraction(&o.Duration, dayFrac, 24*time.Hour)
+	case "d":
+		calendarOK = addWhole(&o.Days, whole)
+		durationOK = addFraction(&o.Duration, frac, 24*time.Hour)
+	case "h":
+		durationOK = addFraction(&o.Duration, num, time.Hour)
+	case "m":
+		durationOK = addFraction(&o.Duration, num, time.Minute)
+	case "s":
+		durationOK = addFraction(&o.Duration, num, time.Second)
+	default:
+		return inputError(ErrUnsupportedUnit, unit)
+	}
+	if !calendarOK {
+		return &InputError{Kind: ErrOutOfRange, Type: "int", Value: value + unit, Position: -1}
+	}
+	if !durationOK {
+		return &InputError{Kind: ErrOutOfRange, Type: "time.Duration", Value: value + unit, Position: -1}
+	}
+	return nil
+}
+
+// addWhole 將非負整數累加到 dst，超出 int 範圍時不修改 dst 並回傳 false
+func addWhole(dst *int, value float64) bool {
+	if value >= math.MaxInt {
+		return false
+	}
+	n := int(value)
+	if *dst > math.MaxInt-n {
+		return false
+	}
+	*dst += n
+	return true
+}
+
+// addFraction 將非負數值乘以單位長度並四捨五入到納秒後累加到 dst
+// 超出 time.Duration 範圍時不修改 dst 並回傳 false
+func addFraction(dst *time.Duration, value float64, unit time.Duration) bool {
+	d := math.Round(value * float64(unit))
+	if d >= math.MaxInt64 {
+		return false
+	}
+	n := time.Duration(d)
+	if *dst > math.MaxInt64-n {
+		return false
+	}
+	*dst += n
+	return true
+}
+
+// Negate 回傳方向相反的偏移
+func (o Offset) Negate() Offset {
+	return Offset{Years: -o.Years, Months: -o.Months, Days: -o.Days, Duration: -o.Duration}
+}
+
+// Apply 將偏移套用到時間，先以 AddDate 處理年月日，再加上固定長度
+func (o Offset) Apply(t time.Time) time.Time {
+	return t.AddDate(o.Years, o.Months, o.Days).Add(o.Duration)
+}
+
+// Approximate 將偏移換算為近似的 Duration (月以 30 天、年以 365 天計)
+func (o Offset) Approximate() time.Duration {
+	days := time.Duration(o.Years*365+o.Months*30+o.Days) * 24 * time.Hour
+	return days + o.Duration
+}
@@ -0,0 +1,87 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAddTimeOffsetCompound(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+	baseTime := time.Date(2022, 1, 31, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		offset   string
+		expected time.Time
+		wantErr  bool
+	}{
+		{"minus 1 day 6 hours", "-1d6h", time.Date(2022, 1, 30, 6, 0, 0, 0, time.UTC), false},
+		{"plus 2 weeks 3 days", "+2w3d", time.Date(2022, 2, 17, 12, 0, 0, 0, time.UTC), false},
+		{"unsigned compound", "1h30m15s", time.Date(2022, 1, 31, 13, 30, 15, 0, time.UTC), false},
+		{"fractional hours", "1.5h", time.Date(2022, 1, 31, 13, 30, 0, 0, time.UTC), false},
+		{"fractional seconds", "-0.25s", time.Date(2022, 1, 31, 11, 59, 59, 750000000, time.UTC), false},
+		{"fractional days", "+1.5d", time.Date(2022, 2, 2, 0, 0, 0, 0, time.UTC), false},
+		{"month end uses AddDate", "+1M", time.Date(2022, 3, 3, 12, 0, 0, 0, time.UTC), false},
+		{"year and month", "-1y1M", time.Date(2020, 12, 31, 12, 0, 0, 0, time.UTC), false},
+		{"ISO 8601 full", "P1Y2M10DT2H30M", time.Date(2023, 4, 10, 14, 30, 0, 0, time.UTC), false},
+		{"ISO 8601 negative time only", "-PT15M", time.Date(2022, 1, 31, 11, 45, 0, 0, time.UTC), false},
+		{"ISO 8601 weeks", "P2W", time.Date(2022, 2, 14, 12, 0, 0, 0, time.UTC), false},
+		{"ISO 8601 comma decimal", "PT0,5S", time.Date(2022, 1, 31, 12, 0, 0, 500000000, time.UTC), false},
+		{"fractional month rejected", "1.5M", baseTime, true},
+		{"trailing garbage", "1d6", baseTime, true},
+		{"leading garbage", "x1d", baseTime, true},
+		{"unknown unit in chain", "1d2x", baseTime, true},
+		{"double sign", "+-1d", baseTime, true},
+		{"empty ISO duration", "P", baseTime, true},
+		{"ISO time designator without value", "P1DT", baseTime, true},
+		{"ISO out of order", "PT1H1D", baseTime, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := conv.AddTimeOffset(baseTime, tt.offset)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddTimeOffset(%q) error = %v, wantErr %v", tt.offset, err, tt.wantErr)
+			}
+			if !tt.wantErr && !result.Equal(tt.expected) {
+				t.Errorf("AddTimeOffset(%q) = %v, want %v", tt.offset, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseOffset(t *testing.T) {
+	tests := []struct {
+		offset string
+		want   Offset
+	}{
+		{"", Offset{}},
+		{"-1d6h", Offset{Days: -1, Duration: -6 * time.Hour}},
+		{"1.5w", Offset{Days: 10, Duration: 12 * time.Hour}},
+		{"P1Y2M3DT4H5M6.5S", Offset{Years: 1, Months: 2, Days: 3, Duration: 4*time.Hour + 5*time.Minute + 6500*time.Millisecond}},
+		// time.Duration 的上限為 2562047h47m16.854775807s
+		{"2562047h47m16s", Offset{Duration: 2562047*time.Hour + 47*time.Minute + 16*time.Second}},
+		{"-2562047h47m16s", Offset{Duration: -(2562047*time.Hour + 47*time.Minute + 16*time.Second)}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseOffset(tt.offset)
+		if err != nil {
+			t.Fatalf("ParseOffset(%q) error = %v", tt.offset, err)
+		}
+		if got != tt.want {
+			t.Errorf("ParseOffset(%q) = %+v, want %+v", tt.offset, got, tt.want)
+		}
+	}
+}
+
+func TestParseTimeOffsetCompound(t *testing.T) {
+	got, err := ParseTimeOffset("-1d6h")
+	if err != nil {
+		t.Fatalf("ParseTimeOffset error = %v", err)
+	}
+	if want := -30 * time.Hour; got != want {
+		t.Errorf("ParseTimeOffset(\"-1d6h\") = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,115 @@
+package converter
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// RelativeUnits 相對時間可用的單位，由小到大排列
+var RelativeUnits = []string{"second", "minute", "hour", "day", "week", "month", "year"}
+
+// RelativeTime 相對於參考時間的描述，如 "3 hours ago"
+type RelativeTime struct {
+	Value     int    `json:"value"`
+	Unit      string `json:"unit"`
+	Future    bool   `json:"future"`
+	Text      string `json:"text"`
+	Reference string `json:"reference"`
+}
+
+// ValidateGranularity 檢查相對時間的最小單位是否有效
+func ValidateGranularity(unit string) error {
+	if unit == "" || relativeUnitIndex(unit) >= 0 {
+		return nil
+	}
+	return inputError(ErrUnsupportedUnit, unit)
+}
+
+// relativeUnitIndex 取得單位在 RelativeUnits 中的位置
+func relativeUnitIndex(unit string) int {
+	for i, u := range RelativeUnits {
+		if u == unit {
+			return i
+		}
+	}
+	return -1
+}
+
+// RelativeTo 計算 t 相對於 ref 的描述
+// 取不小於 Converter.Granularity 的最大非零單位；不足一個最小單位時 Value 為 0
+// 月與年依 Converter.Location 的日曆計算
+func (c *Converter) RelativeTo(t, ref time.Time) RelativeTime {
+	t = t.In(c.Location)
+	ref = ref.In(c.Location)
+
+	rel := RelativeTime{
+		Future:    t.After(ref),
+		Reference: ref.Format(time.RFC3339),
+	}
+
+	start, end := t, ref
+	if rel.Future {
+		start, end = ref, t
+	}
+	cal := calendarDiff(start, end)
+	elapsed := end.Sub(start)
+
+	// 以向下取整選出單位，再將固定長度的單位四捨五入，避免 1 天 23 小時顯示為 1 天
+	days := civilDaysBetween(start, end)
+	floors := []int{
+		int(elapsed / time.Second),
+		int(elapsed / time.Minute),
+		int(elapsed / time.Hour),
+		days,
+		days / 7,
+		cal.Years*12 + cal.Months,
+		cal.Years,
+	}
+	lengths := []time.Duration{time.Second, time.Minute, time.Hour, 24 * time.Hour, 7 * 24 * time.Hour}
+
+	minIdx := relativeUnitIndex(c.Granularity)
+	if minIdx < 0 {
+		minIdx = 0
+	}
+
+	rel.Unit = RelativeUnits[minIdx]
+	for i := len(RelativeUnits) - 1; i >= minIdx; i-- {
+		if floors[i] == 0 {
+			continue
+		}
+		rel.Unit = RelativeUnits[i]
+		rel.Value = floors[i]
+		if i < len(lengths) {
+			rel.Value = int(math.Round(float64(elapsed) / float64(lengths[i])))
+		}
+		break
+	}
+
+	rel.Text = rel.English()
+	return rel
+}
+
+// civilDaysBetween 計算經過的完整天數 (以牆上時間判斷)
+func civilDaysBetween(start, end time.Time) int {
+	days := civilDays(end) - civilDays(start)
+	if days > 0 && start.AddDate(0, 0, days).After(end) {
+		days--
+	}
+	return days
+}
+
+// English 以英文描述相對時間，如 "3 hours ago"、"in 2 days"
+func (r RelativeTime) English() string {
+	if r.Value == 0 {
+		return "just now"
+	}
+	unit := r.Unit
+	if r.Value != 1 {
+		unit += "s"
+	}
+	if r.Future {
+		return fmt.Sprintf("in %d %s", r.Value, unit)
+	}
+	return fmt.Sprintf("%d %s ago", r.Value, unit)
+}
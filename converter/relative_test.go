@@ -0,0 +1,76 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelativeTo(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+	ref := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		t           time.Time
+		granularity string
+		wantValue   int
+		wantUnit    string
+		wantFuture  bool
+		wantText    string
+	}{
+		{"same instant", ref, "", 0, "second", false, "just now"},
+		{"seconds ago", ref.Add(-45 * time.Second), "", 45, "second", false, "45 seconds ago"},
+		{"one minute ago", ref.Add(-70 * time.Second), "", 1, "minute", false, "1 minute ago"},
+		{"hours ago", ref.Add(-3*time.Hour - 10*time.Minute), "", 3, "hour", false, "3 hours ago"},
+		{"rounded days", ref.Add(-47 * time.Hour), "", 2, "day", false, "2 days ago"},
+		{"in days", ref.Add(48 * time.Hour), "", 2, "day", true, "in 2 days"},
+		{"weeks", ref.AddDate(0, 0, -15), "", 2, "week", false, "2 weeks ago"},
+		{"calendar months", ref.AddDate(0, -2, -3), "", 2, "month", false, "2 months ago"},
+		{"in a year", ref.AddDate(1, 1, 0), "", 1, "year", true, "in 1 year"},
+		{"granularity day hides hours", ref.Add(-5 * time.Hour), "day", 0, "day", false, "just now"},
+		{"granularity hour", ref.Add(-30 * time.Hour), "hour", 1, "day", false, "1 day ago"},
+		{"granularity month", ref.AddDate(0, 0, -20), "month", 0, "month", false, "just now"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv.Granularity = tt.granularity
+			rel := conv.RelativeTo(tt.t, ref)
+			if rel.Value != tt.wantValue || rel.Unit != tt.wantUnit || rel.Future != tt.wantFuture {
+				t.Errorf("RelativeTo() = %d %s future=%v, want %d %s future=%v",
+					rel.Value, rel.Unit, rel.Future, tt.wantValue, tt.wantUnit, tt.wantFuture)
+			}
+			if rel.Text != tt.wantText {
+				t.Errorf("RelativeTo().Text = %q, want %q", rel.Text, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestConvertRelativeUsesReference(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+	conv.Now = func() time.Time { return time.Date(2022, 1, 24, 16, 7, 14, 0, time.UTC) }
+
+	result, err := conv.Convert("1642781234", nil)
+	if err != nil {
+		t.Fatalf("Convert failed: %v", err)
+	}
+	if result.Relative.Text != "3 days ago" {
+		t.Errorf("Relative.Text = %q, want %q", result.Relative.Text, "3 days ago")
+	}
+	if result.Relative.Reference != "2022-01-24T16:07:14Z" {
+		t.Errorf("Relative.Reference = %q", result.Relative.Reference)
+	}
+}
+
+func TestValidateGranularity(t *testing.T) {
+	for _, unit := range append([]string{""}, RelativeUnits...) {
+		if err := ValidateGranularity(unit); err != nil {
+			t.Errorf("ValidateGranularity(%q) error = %v", unit, err)
+		}
+	}
+	if err := ValidateGranularity("decade"); err == nil {
+		t.Error("ValidateGranularity(\"decade\") expected error")
+	}
+}
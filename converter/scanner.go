@@ -0,0 +1,65 @@
+package converter
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Match 在任意文字中找到的時間戳
+type Match struct {
+	Start  int             // 起始位元組位置 (含)
+	End    int             // 結束位元組位置 (不含)
+	Text   string          // 符合的原始文字
+	Format TimestampFormat // 偵測到的格式
+}
+
+// timestampPattern 用於在文字中尋找候選時間戳
+// 較長的格式放在前面，讓 RFC3339 / 日期時間優先於其中的日期部分
+// 純數字只接受 10/13/16/18/19 位，避免把埠號、行號等誤判為時間戳
+// 單獨的時間 (HH:MM:SS) 不列入，因為沒有日期資訊無法正確標註
+var timestampPattern = regexp.MustCompile(
+	`\b\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(?:\.\d+)?(?:Z|[+-]\d{2}:\d{2})` +
+		`|\b\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}\b` +
+		`|\b\d{4}-\d{2}-\d{2}\b` +
+		`|\b\d{10}(?:\d{3}){0,3}\b` +
+		`|\b\d{18}\b`)
+
+// FindTimestamps 掃描任意文字，回傳所有可被 DetectFormat 辨識且能成功解析的時間戳位置
+func (c *Converter) FindTimestamps(text string) []Match {
+	var matches []Match
+	for _, loc := range timestampPattern.FindAllStringIndex(text, -1) {
+		candidate := text[loc[0]:loc[1]]
+		format, err := c.DetectFormat(candidate)
+		if err != nil {
+			continue
+		}
+		if _, err := c.Parse(candidate, format); err != nil {
+			continue
+		}
+		matches = append(matches, Match{
+			Start:  loc[0],
+			End:    loc[1],
+			Text:   candidate,
+			Format: format,
+		})
+	}
+	return matches
+}
+
+// ReplaceTimestamps 將文字中找到的每個時間戳替換為 repl 的回傳值
+func (c *Converter) ReplaceTimestamps(text string, repl func(Match) string) string {
+	matches := c.FindTimestamps(text)
+	if len(matches) == 0 {
+		return text
+	}
+
+	var sb strings.Builder
+	last := 0
+	for _, m := range matches {
+		sb.WriteString(text[last:m.Start])
+		sb.WriteString(repl(m))
+		last = m.End
+	}
+	sb.WriteString(text[last:])
+	return sb.String()
+}
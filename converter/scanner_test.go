@@ -0,0 +1,105 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFindTimestamps(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		name        string
+		text        string
+		wantTexts   []string
+		wantFormats []TimestampFormat
+	}{
+		{
+			"epoch millis in log line",
+			"INFO ts=1642781234000 request done",
+			[]string{"1642781234000"},
+			[]TimestampFormat{UnixMilliseconds()},
+		},
+		{
+			"json blob with several timestamps",
+			`{"created":1642781234,"updated":"2022-01-21T12:00:34.5Z"}`,
+			[]string{"1642781234", "2022-01-21T12:00:34.5Z"},
+			[]TimestampFormat{UnixSeconds(), RFC3339Nano()},
+		},
+		{
+			"datetime preferred over its date part",
+			"at 2022-01-21 12:00:34 and on 2022-01-22",
+			[]string{"2022-01-21 12:00:34", "2022-01-22"},
+			[]TimestampFormat{DateTime(), DateOnly()},
+		},
+		{
+			"RFC3339 with offset",
+			"start=2022-01-21T12:00:34+08:00;",
+			[]string{"2022-01-21T12:00:34+08:00"},
+			[]TimestampFormat{RFC3339()},
+		},
+		{
+			"AD lastLogonTimestamp",
+			"lastLogonTimestamp: 132872548340000000",
+			[]string{"132872548340000000"},
+			[]TimestampFormat{FileTime()},
+		},
+		{
+			"short and odd-length numbers ignored",
+			"port 8080 pid 12345 id 12345678901",
+			nil,
+			nil,
+		},
+		{
+			"digits embedded in identifiers ignored",
+			"trace-abc1642781234def",
+			nil,
+			nil,
+		},
+		{
+			"invalid calendar date ignored",
+			"2022-13-45",
+			nil,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := conv.FindTimestamps(tt.text)
+			if len(matches) != len(tt.wantTexts) {
+				t.Fatalf("FindTimestamps(%q) returned %d matches, want %d: %+v", tt.text, len(matches), len(tt.wantTexts), matches)
+			}
+			for i, m := range matches {
+				if m.Text != tt.wantTexts[i] {
+					t.Errorf("match %d Text = %q, want %q", i, m.Text, tt.wantTexts[i])
+				}
+				if tt.text[m.Start:m.End] != m.Text {
+					t.Errorf("match %d span [%d:%d] = %q, want %q", i, m.Start, m.End, tt.text[m.Start:m.End], m.Text)
+				}
+				if m.Format != tt.wantFormats[i] {
+					t.Errorf("match %d Format = %v, want %v", i, m.Format, tt.wantFormats[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReplaceTimestamps(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	text := "a=1642781234 b=2022-01-21"
+	got := conv.ReplaceTimestamps(text, func(m Match) string {
+		parsed, _ := conv.Parse(m.Text, m.Format)
+		return fmt.Sprintf("%s[%s]", m.Text, parsed.Format("15:04"))
+	})
+	want := "a=1642781234[16:07] b=2022-01-21[00:00]"
+	if got != want {
+		t.Errorf("ReplaceTimestamps() = %q, want %q", got, want)
+	}
+
+	if got := conv.ReplaceTimestamps("no timestamps here", nil); got != "no timestamps here" {
+		t.Errorf("ReplaceTimestamps() without matches = %q", got)
+	}
+}
@@ -0,0 +1,194 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Strftime 依 C/POSIX strftime 樣式格式化時間
+//
+// 支援的指令:
+//
+//	%a %A %b %B %c %C %d %D %e %F %g %G %h %H %I %j %k %l %m %M %n
+//	%N %p %P %r %R %s %S %t %T %u %U %V %w %W %x %X %y %Y %z %:z %Z %%
+//
+// %N 為 9 位數的納秒，可加寬度指定位數，如 %3N 為毫秒
+// 遇到不支援的指令時回傳錯誤
+func Strftime(t time.Time, pattern string) (string, error) {
+	var sb strings.Builder
+	sb.Grow(len(pattern) + 16)
+
+	for i := 0; i < len(pattern); i++ {
+		ch := pattern[i]
+		if ch != '%' {
+			sb.WriteByte(ch)
+			continue
+		}
+		if i+1 >= len(pattern) {
+			return "", &InputError{Kind: ErrUnsupportedDirective, Type: "strftime", Value: "%", Position: i}
+		}
+		i++
+
+		// %3N 之類的寬度指定 (僅適用於 %N)
+		width := 0
+		start := i
+		for i < len(pattern) && pattern[i] >= '0' && pattern[i] <= '9' {
+			width = width*10 + int(pattern[i]-'0')
+			i++
+		}
+		if i >= len(pattern) {
+			return "", &InputError{Kind: ErrUnsupportedDirective, Type: "strftime", Value: pattern[start-1:], Position: start - 1}
+		}
+		if i > start && pattern[i] != 'N' {
+			return "", &InputError{Kind: ErrUnsupportedDirective, Type: "strftime", Value: pattern[start-1 : i+1], Position: start - 1}
+		}
+
+		// %:z 為帶冒號的時區偏移
+		if pattern[i] == ':' {
+			if i+1 < len(pattern) && pattern[i+1] == 'z' {
+				i++
+				sb.WriteString(t.Format("-07:00"))
+				continue
+			}
+			return "", &InputError{Kind: ErrUnsupportedDirective, Type: "strftime", Value: "%:", Position: i - 1}
+		}
+
+		if err := writeStrftimeDirective(&sb, t, pattern[i], width); err != nil {
+			return "", atPosition(err, start-1)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// writeStrftimeDirective 輸出單一 strftime 指令
+func writeStrftimeDirective(sb *strings.Builder, t time.Time, directive byte, width int) error {
+	switch directive {
+	case 'a': // 星期縮寫
+		sb.WriteString(t.Format("Mon"))
+	case 'A': // 星期全名
+		sb.WriteString(t.Format("Monday"))
+	case 'b', 'h': // 月份縮寫
+		sb.WriteString(t.Format("Jan"))
+	case 'B': // 月份全名
+		sb.WriteString(t.Format("January"))
+	case 'c': // 日期與時間 (C locale)
+		sb.WriteString(t.Format("Mon Jan _2 15:04:05 2006"))
+	case 'C': // 世紀
+		fmt.Fprintf(sb, "%02d", floorDiv(t.Year(), 100))
+	case 'd': // 日 (01-31)
+		fmt.Fprintf(sb, "%02d", t.Day())
+	case 'D': // %m/%d/%y
+		sb.WriteString(t.Format("01/02/06"))
+	case 'e': // 日，前補空白
+		fmt.Fprintf(sb, "%2d", t.Day())
+	case 'F': // %Y-%m-%d
+		fmt.Fprintf(sb, "%04d-%02d-%02d", t.Year(), t.Month(), t.Day())
+	case 'g': // ISO 8601 週年的後兩位
+		year, _ := t.ISOWeek()
+		fmt.Fprintf(sb, "%02d", mod(year, 100))
+	case 'G': // ISO 8601 週年
+		year, _ := t.ISOWeek()
+		fmt.Fprintf(sb, "%04d", year)
+	case 'H': // 小時 (00-23)
+		fmt.Fprintf(sb, "%02d", t.Hour())
+	case 'I': // 小時 (01-12)
+		fmt.Fprintf(sb, "%02d", hour12(t.Hour()))
+	case 'j': // 一年中的第幾天 (001-366)
+		fmt.Fprintf(sb, "%03d", t.YearDay())
+	case 'k': // 小時 (0-23)，前補空白
+		fmt.Fprintf(sb, "%2d", t.Hour())
+	case 'l': // 小時 (1-12)，前補空白
+		fmt.Fprintf(sb, "%2d", hour12(t.Hour()))
+	case 'm': // 月 (01-12)
+		fmt.Fprintf(sb, "%02d", int(t.Month()))
+	case 'M': // 分 (00-59)
+		fmt.Fprintf(sb, "%02d", t.Minute())
+	case 'n':
+		sb.WriteByte('\n')
+	case 'N': // 納秒，可指定位數
+		nanos := fmt.Sprintf("%09d", t.Nanosecond())
+		if width > 0 && width < 9 {
+			nanos = nanos[:width]
+		}
+		sb.WriteString(nanos)
+	case 'p': // AM/PM
+		sb.WriteString(t.Format("PM"))
+	case 'P': // am/pm
+		sb.WriteString(t.Format("pm"))
+	case 'r': // %I:%M:%S %p
+		sb.WriteString(t.Format("03:04:05 PM"))
+	case 'R': // %H:%M
+		sb.WriteString(t.Format("15:04"))
+	case 's': // Unix 秒級時間戳
+		sb.WriteString(strconv.FormatInt(t.Unix(), 10))
+	case 'S': // 秒 (00-60)
+		fmt.Fprintf(sb, "%02d", t.Second())
+	case 't':
+		sb.WriteByte('\t')
+	case 'T': // %H:%M:%S
+		sb.WriteString(t.Format("15:04:05"))
+	case 'u': // 星期 (1-7，星期一為 1)
+		wd := int(t.Weekday())
+		if wd == 0 {
+			wd = 7
+		}
+		sb.WriteString(strconv.Itoa(wd))
+	case 'U': // 一年中的第幾週 (00-53，週日為一週的第一天)
+		fmt.Fprintf(sb, "%02d", (t.YearDay()+6-int(t.Weekday()))/7)
+	case 'V': // ISO 8601 週數 (01-53)
+		_, week := t.ISOWeek()
+		fmt.Fprintf(sb, "%02d", week)
+	case 'w': // 星期 (0-6，星期日為 0)
+		sb.WriteString(strconv.Itoa(int(t.Weekday())))
+	case 'W': // 一年中的第幾週 (00-53，週一為一週的第一天)
+		fmt.Fprintf(sb, "%02d", (t.YearDay()+6-(int(t.Weekday())+6)%7)/7)
+	case 'x': // 日期 (C locale)
+		sb.WriteString(t.Format("01/02/06"))
+	case 'X': // 時間 (C locale)
+		sb.WriteString(t.Format("15:04:05"))
+	case 'y': // 年的後兩位
+		fmt.Fprintf(sb, "%02d", mod(t.Year(), 100))
+	case 'Y': // 年
+		fmt.Fprintf(sb, "%04d", t.Year())
+	case 'z': // 時區偏移 +hhmm
+		sb.WriteString(t.Format("-0700"))
+	case 'Z': // 時區縮寫
+		zone, _ := t.Zone()
+		sb.WriteString(zone)
+	case '%':
+		sb.WriteByte('%')
+	default:
+		return &InputError{Kind: ErrUnsupportedDirective, Type: "strftime", Value: "%" + string(directive), Position: -1}
+	}
+	return nil
+}
+
+// hour12 將 24 小時制轉為 12 小時制
+func hour12(hour int) int {
+	hour %= 12
+	if hour == 0 {
+		return 12
+	}
+	return hour
+}
+
+// floorDiv 向下取整的整數除法
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// mod 結果恆為非負的取餘數
+func mod(a, b int) int {
+	m := a % b
+	if m < 0 {
+		m += b
+	}
+	return m
+}
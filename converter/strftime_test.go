@@ -0,0 +1,79 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStrftime(t *testing.T) {
+	taipei, _ := time.LoadLocation("Asia/Taipei")
+	// 2022-01-02 是星期日，ISO 週年仍屬 2021 年第 52 週
+	base := time.Date(2022, 1, 2, 15, 4, 5, 123456789, taipei)
+
+	tests := []struct {
+		name    string
+		pattern string
+		want    string
+	}{
+		{"date", "%Y/%m/%d %H:%M", "2022/01/02 15:04"},
+		{"names", "%a %A %b %B %h", "Sun Sunday Jan January Jan"},
+		{"composites", "%F %T %D %R", "2022-01-02 15:04:05 01/02/22 15:04"},
+		{"c locale", "%c|%x|%X", "Sun Jan  2 15:04:05 2022|01/02/22|15:04:05"},
+		{"padding", "[%e][%k][%l]", "[ 2][15][ 3]"},
+		{"12 hour", "%I %p %P %r", "03 PM pm 03:04:05 PM"},
+		{"day of year", "%j", "002"},
+		{"week numbers", "%U %W %V", "01 00 52"},
+		{"iso week year", "%G %g", "2021 21"},
+		{"weekday numbers", "%u %w", "7 0"},
+		{"century and year", "%C %y", "20 22"},
+		{"epoch", "%s", "1641107045"},
+		{"nanoseconds", "%N", "123456789"},
+		{"nanoseconds width", "%3N %6N", "123 123456"},
+		{"zone", "%z %:z %Z", "+0800 +08:00 CST"},
+		{"literals", "%% %n%t", "% \n\t"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Strftime(base, tt.pattern)
+			if err != nil {
+				t.Fatalf("Strftime(%q) error = %v", tt.pattern, err)
+			}
+			if got != tt.want {
+				t.Errorf("Strftime(%q) = %q, want %q", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrftimeWeekBoundaries(t *testing.T) {
+	tests := []struct {
+		date    time.Time
+		pattern string
+		want    string
+	}{
+		{time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), "%G-W%V-%u", "2025-W01-1"},
+		{time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC), "%G-W%V-%u", "2020-W53-7"},
+		{time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), "%U %W", "01 00"},
+		{time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), "%j %U %W", "366 52 53"},
+	}
+
+	for _, tt := range tests {
+		got, err := Strftime(tt.date, tt.pattern)
+		if err != nil {
+			t.Fatalf("Strftime(%v, %q) error = %v", tt.date, tt.pattern, err)
+		}
+		if got != tt.want {
+			t.Errorf("Strftime(%v, %q) = %q, want %q", tt.date, tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestStrftimeInvalid(t *testing.T) {
+	for _, pattern := range []string{"%Q", "%", "abc %", "%3Y", "%:x"} {
+		if _, err := Strftime(time.Now(), pattern); err == nil {
+			t.Errorf("Strftime(%q) expected error", pattern)
+		}
+	}
+}
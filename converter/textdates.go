@@ -0,0 +1,143 @@
+package converter
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// httpDateLayout HTTP 標頭使用的 IMF-fixdate，固定以 GMT 表示
+	httpDateLayout = "Mon, 02 Jan 2006 15:04:05 GMT"
+
+	// goStringLayout Go time.Time.String() 的輸出格式，不含單調時鐘讀數
+	goStringLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+)
+
+var (
+	// mailDatePattern RFC 1123 與 RFC 822/2822 日期，如 "Mon, 02 Jan 2006 15:04:05 GMT"
+	// 星期與秒數可省略，年份為 2 或 4 位，時區可為縮寫或數字偏移，結尾可帶註解 (如 "(CEST)")
+	mailDatePattern = regexp.MustCompile(`^(?:(?:Mon|Tue|Wed|Thu|Fri|Sat|Sun), )?\d{1,2} (?:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec) (\d{2}|\d{4}) \d{2}:\d{2}(:\d{2})? ([+-]\d{4}|[A-Za-z]{3,5})(?: \([^)]*\))?$`)
+
+	// mailCommentPattern 郵件日期結尾的註解
+	mailCommentPattern = regexp.MustCompile(` \([^)]*\)$`)
+
+	// rfc850Pattern HTTP/1.1 仍接受的舊式 RFC 850 日期，如 "Monday, 02-Jan-06 15:04:05 GMT"
+	rfc850Pattern = regexp.MustCompile(`^(?:Mon|Tues|Wednes|Thurs|Fri|Satur|Sun)day, \d{2}-(?:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)-\d{2} \d{2}:\d{2}:\d{2} [A-Za-z]{3,5}$`)
+
+	// asctimePattern ANSIC 與 Unix date 格式，如 "Mon Jan  2 15:04:05 2006"、"Mon Jan  2 15:04:05 MST 2006"
+	asctimePattern = regexp.MustCompile(`^(?:Mon|Tue|Wed|Thu|Fri|Sat|Sun) (?:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec) +\d{1,2} \d{2}:\d{2}:\d{2} (?:([A-Za-z]{3,5}|[+-]\d{4}) )?\d{4}$`)
+
+	// goStringPattern Go time.Time.String() 的輸出，可帶單調時鐘讀數 (如 "m=+0.1")
+	goStringPattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}(?:\.\d+)? [+-]\d{4} \S+(?: m=[+-]\d+(?:\.\d+)?)?$`)
+)
+
+// mailLayouts RFC 1123 與 RFC 822/2822 日期可能的版面，依星期、年份位數、秒數與時區形式組合
+var mailLayouts = func() []string {
+	var layouts []string
+	for _, weekday := range []string{"Mon, ", ""} {
+		for _, year := range []string{"2006", "06"} {
+			for _, clock := range []string{"15:04:05", "15:04"} {
+				for _, zone := range []string{"MST", "-0700"} {
+					layouts = append(layouts, weekday+"2 Jan "+year+" "+clock+" "+zone)
+				}
+			}
+		}
+	}
+	return layouts
+}()
+
+// rfc822Zones RFC 822 定義的時區縮寫，作為 Converter.Location 未使用該縮寫時的備援
+var rfc822Zones = map[string]int{
+	"GMT": 0,
+	"EST": -5 * 3600,
+	"EDT": -4 * 3600,
+	"CST": -6 * 3600,
+	"CDT": -5 * 3600,
+	"MST": -7 * 3600,
+	"MDT": -6 * 3600,
+	"PST": -8 * 3600,
+	"PDT": -7 * 3600,
+}
+
+// detectTextDate 偵測 RFC 1123、RFC 822/2822、ANSIC、Unix date 與 Go time.String() 格式
+func detectTextDate(input string) (TimestampFormat, bool) {
+	switch {
+	case goStringPattern.MatchString(input):
+		return GoString(), true
+	case rfc850Pattern.MatchString(input):
+		return RFC1123(), true
+	}
+
+	if m := mailDatePattern.FindStringSubmatch(input); m != nil {
+		// 帶星期、4 位數年份、秒數與時區縮寫的為 RFC 1123 (HTTP 日期)，其餘為郵件日期
+		weekday := strings.Contains(input, ",")
+		numericZone := strings.ContainsAny(m[3][:1], "+-")
+		if weekday && len(m[1]) == 4 && m[2] != "" && !numericZone {
+			return RFC1123(), true
+		}
+		return RFC822(), true
+	}
+
+	if m := asctimePattern.FindStringSubmatch(input); m != nil {
+		if m[1] != "" {
+			return UnixDate(), true
+		}
+		return ANSIC(), true
+	}
+	return TimestampFormat{}, false
+}
+
+// parseMailDate 解析 RFC 1123 (含 RFC 850) 與 RFC 822/2822 日期，忽略結尾的註解
+func (c *Converter) parseMailDate(input string) (time.Time, error) {
+	input = mailCommentPattern.ReplaceAllString(input, "")
+	return c.parseTextDate(input, append(mailLayouts, time.RFC850))
+}
+
+// parseGoString 解析 Go time.Time.String() 的輸出，忽略單調時鐘讀數
+func (c *Converter) parseGoString(input string) (time.Time, error) {
+	if i := strings.Index(input, " m="); i >= 0 {
+		input = input[:i]
+	}
+	return c.parseTextDate(input, []string{goStringLayout})
+}
+
+// parseTextDate 依序嘗試 layouts 解析文字日期
+// 只有時區縮寫而無數字偏移時，縮寫依 Converter.Location 解讀
+func (c *Converter) parseTextDate(input string, layouts []string) (time.Time, error) {
+	var firstErr error
+	for _, layout := range layouts {
+		t, err := time.ParseInLocation(layout, input, c.Location)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if strings.Contains(layout, "MST") && !strings.Contains(layout, "-0700") {
+			if t, err = c.resolveZoneAbbr(t); err != nil {
+				return time.Time{}, err
+			}
+		}
+		return t.In(c.Location), nil
+	}
+	return time.Time{}, firstErr
+}
+
+// resolveZoneAbbr 確認時區縮寫的偏移量
+// time.ParseInLocation 已依 Converter.Location 解讀其使用過的縮寫 (如 Asia/Shanghai 的 CST 為 +08:00)，
+// 其餘縮寫改用 RFC 822 的定義；仍無法辨識時回報錯誤，而非默默視為 UTC
+func (c *Converter) resolveZoneAbbr(t time.Time) (time.Time, error) {
+	name, offset := t.Zone()
+	if t.Location() == c.Location || t.Location() == time.UTC || offset != 0 {
+		return t, nil
+	}
+
+	off, ok := rfc822Zones[name]
+	if !ok {
+		return time.Time{}, inputError(ErrUnknownZone, name)
+	}
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	return time.Date(year, month, day, hour, min, sec, t.Nanosecond(), time.FixedZone(name, off)), nil
+}
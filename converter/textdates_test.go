@@ -0,0 +1,156 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDetectTextDates(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		input string
+		want  TimestampFormat
+	}{
+		{"Fri, 21 Jan 2022 16:07:14 GMT", RFC1123()},
+		{"Friday, 21-Jan-22 16:07:14 GMT", RFC1123()},
+		{"Fri, 21 Jan 2022 16:07:14 +0800", RFC822()},
+		{"Fri, 21 Jan 2022 16:07:14 +0200 (CEST)", RFC822()},
+		{"21 Jan 22 16:07 UTC", RFC822()},
+		{"Fri Jan 21 16:07:14 2022", ANSIC()},
+		{"Fri Jan  1 16:07:14 2022", ANSIC()},
+		{"Fri Jan 21 16:07:14 UTC 2022", UnixDate()},
+		{"Fri Jan 21 16:07:14 +0000 2022", UnixDate()},
+		{"2022-01-21 16:07:14 +0000 UTC", GoString()},
+		{"2022-01-21 16:07:14.123 +0800 CST m=+0.100000001", GoString()},
+		{"2022-01-21 16:07:14 +0800 +08", GoString()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := conv.DetectFormat(tt.input)
+			if err != nil {
+				t.Fatalf("DetectFormat(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("DetectFormat(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTextDates(t *testing.T) {
+	want := time.Date(2022, 1, 21, 16, 7, 14, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		timezone string
+		input    string
+		want     time.Time
+	}{
+		{"HTTP date", "UTC", "Fri, 21 Jan 2022 16:07:14 GMT", want},
+		{"RFC 850", "UTC", "Friday, 21-Jan-22 16:07:14 GMT", want},
+		{"RFC 2822 offset", "UTC", "Fri, 22 Jan 2022 00:07:14 +0800", want},
+		{"RFC 2822 single digit day", "UTC", "Sat, 1 Jan 2022 09:00:00 -0500", time.Date(2022, 1, 1, 14, 0, 0, 0, time.UTC)},
+		{"mail comment", "UTC", "Fri, 21 Jan 2022 18:07:14 +0200 (EET)", want},
+		{"RFC 822 two digit year", "UTC", "21 Jan 22 16:07 UTC", want.Truncate(time.Minute)},
+		{"ANSIC in location", "Asia/Taipei", "Sat Jan 22 00:07:14 2022", want},
+		{"unix date", "UTC", "Fri Jan 21 16:07:14 UTC 2022", want},
+		{"ruby date", "UTC", "Fri Jan 21 11:07:14 -0500 2022", want},
+		{"go string", "UTC", "2022-01-22 00:07:14.5 +0800 CST m=+0.100000001", want.Add(500 * time.Millisecond)},
+		{"go string unnamed zone", "UTC", "2022-01-22 00:07:14 +0800 +08", want},
+		// 時區縮寫先依 Converter.Location 解讀，再退回 RFC 822 的定義
+		{"CST in Asia/Shanghai", "Asia/Shanghai", "Sat, 22 Jan 2022 00:07:14 CST", want},
+		{"CST in America/Chicago", "America/Chicago", "Fri, 21 Jan 2022 10:07:14 CST", want},
+		{"CST falls back to RFC 822", "UTC", "Fri, 21 Jan 2022 10:07:14 CST", want},
+		{"EDT from unix date", "Asia/Tokyo", "Fri Jan 21 12:07:14 EDT 2022", want},
+		{"JST in Asia/Tokyo", "Asia/Tokyo", "Sat Jan 22 01:07:14 JST 2022", want},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv, err := NewConverter(tt.timezone)
+			if err != nil {
+				t.Fatal(err)
+			}
+			format, err := conv.DetectFormat(tt.input)
+			if err != nil {
+				t.Fatalf("DetectFormat(%q) error = %v", tt.input, err)
+			}
+			got, err := conv.Parse(tt.input, format)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse(%q) = %s, want %s", tt.input, got.Format(time.RFC3339Nano), tt.want.Format(time.RFC3339Nano))
+			}
+			if got.Location() != conv.Location {
+				t.Errorf("Parse(%q) location = %v, want %v", tt.input, got.Location(), conv.Location)
+			}
+		})
+	}
+}
+
+func TestParseTextDateRejectsUnknownZone(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	for _, tt := range []struct {
+		input  string
+		format TimestampFormat
+	}{
+		{"Fri, 21 Jan 2022 16:07:14 XYZ", RFC1123()},
+		{"Fri Jan 21 16:07:14 JST 2022", UnixDate()},
+		{"Fri, 21 Jan 2022", RFC1123()},
+		{"2022-01-21 16:07:14", GoString()},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			if got, err := conv.Parse(tt.input, tt.format); err == nil {
+				t.Errorf("Parse(%q) = %v, want error", tt.input, got)
+			}
+		})
+	}
+}
+
+func TestConvertTextDateOutputs(t *testing.T) {
+	conv, _ := NewConverter("Asia/Taipei")
+
+	result, err := conv.Convert("1642781234", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"rfc1123", result.RFC1123, "Sat, 22 Jan 2022 00:07:14 CST"},
+		{"http_date", result.HTTPDate, "Fri, 21 Jan 2022 16:07:14 GMT"},
+		{"rfc822", result.RFC822, "22 Jan 22 00:07 CST"},
+		{"rfc2822", result.RFC2822, "Sat, 22 Jan 2022 00:07:14 +0800"},
+		{"ansic", result.ANSIC, "Sat Jan 22 00:07:14 2022"},
+		{"unix_date", result.UnixDate, "Sat Jan 22 00:07:14 CST 2022"},
+		{"go_string", result.GoString, "2022-01-22 00:07:14 +0800 CST"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+
+	// 每種輸出都能再以自動偵測解析回同一時間
+	for _, tt := range tests {
+		if tt.name == "rfc822" {
+			continue // 只精確到分鐘
+		}
+		back, err := conv.Convert(tt.got, nil)
+		if err != nil {
+			t.Errorf("Convert(%q) error = %v", tt.got, err)
+			continue
+		}
+		if back.UnixSeconds != result.UnixSeconds {
+			t.Errorf("Convert(%q) = %d, want %d", tt.got, back.UnixSeconds, result.UnixSeconds)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package converter
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	// ticksPerSecond 每秒的 100 奈秒刻度數
+	ticksPerSecond = 10_000_000
+
+	// fileTimeEpochOffset 1601-01-01 (FILETIME / LDAP 紀元) 至 Unix 紀元的秒數
+	fileTimeEpochOffset = 11644473600
+
+	// dotNetEpochOffset 0001-01-01 (.NET DateTime 紀元) 至 Unix 紀元的秒數
+	dotNetEpochOffset = 62135596800
+)
+
+// ticksToTime 將自紀元起的 100 奈秒刻度轉為時間
+func ticksToTime(ticks, epochOffset int64) time.Time {
+	secs, rem := ticks/ticksPerSecond, ticks%ticksPerSecond
+	if rem < 0 {
+		secs--
+		rem += ticksPerSecond
+	}
+	return time.Unix(secs-epochOffset, rem*100)
+}
+
+// timeToTicks 將時間轉為自紀元起的 100 奈秒刻度
+func timeToTicks(t time.Time, epochOffset int64) int64 {
+	return (t.Unix()+epochOffset)*ticksPerSecond + int64(t.Nanosecond()/100)
+}
+
+// ToFileTime 將時間轉為 Windows FILETIME (自 1601-01-01 UTC 起的 100 奈秒刻度)
+// LDAP / Active Directory 的 lastLogonTimestamp 等屬性使用相同的表示法
+func ToFileTime(t time.Time) int64 {
+	return timeToTicks(t, fileTimeEpochOffset)
+}
+
+// ToDotNetTicks 將時間轉為 .NET DateTime.Ticks (自 0001-01-01 UTC 起的 100 奈秒刻度)
+func ToDotNetTicks(t time.Time) int64 {
+	return timeToTicks(t, dotNetEpochOffset)
+}
+
+// parseTicks 解析 100 奈秒刻度的數字
+func (c *Converter) parseTicks(input string, epochOffset int64) (time.Time, error) {
+	ticks, err := strconv.ParseInt(input, 10, 64)
+	if err != nil {
+		return time.Time{}, inputError(ErrInvalidNumber, input)
+	}
+	return ticksToTime(ticks, epochOffset).In(c.Location), nil
+}
@@ -0,0 +1,95 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDetectTicks(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		name  string
+		input string
+		want  TimestampFormat
+	}{
+		{"FILETIME", "132872548340000000", FileTime()},
+		{"FILETIME 1970 ranks below epoch ns 1973", "116444736000000000", UnixNanoseconds()},
+		{".NET ticks", "637783780340000000", DotNetTicks()},
+		{"18-digit epoch ns", "999999999999999999", UnixNanoseconds()},
+		{"18-digit epoch ns before FILETIME range", "100000000000000000", UnixNanoseconds()},
+		{"19-digit epoch ns", "1642781234000000000", UnixNanoseconds()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := conv.DetectFormat(tt.input)
+			if err != nil {
+				t.Fatalf("DetectFormat(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("DetectFormat(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTicks(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+
+	tests := []struct {
+		name   string
+		input  string
+		format TimestampFormat
+		want   time.Time
+	}{
+		{"FILETIME", "132872548340000000", FileTime(), time.Unix(1642781234, 0)},
+		{"FILETIME sub-second", "132872548341234567", FileTime(), time.Unix(1642781234, 123456700)},
+		{"FILETIME epoch", "0", FileTime(), time.Date(1601, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"LDAP", "132872548340000000", LDAP(), time.Unix(1642781234, 0)},
+		{".NET ticks", "637783780340000000", DotNetTicks(), time.Unix(1642781234, 0)},
+		{".NET ticks epoch", "0", DotNetTicks(), time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"negative FILETIME", "-10000001", FileTime(), time.Date(1600, 12, 31, 23, 59, 58, 999999900, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := conv.Parse(tt.input, tt.format)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := conv.Parse("not-a-number", FileTime()); err == nil {
+		t.Error("Parse(\"not-a-number\", FileTime) expected error")
+	}
+}
+
+func TestConvertTicksFields(t *testing.T) {
+	conv, _ := NewConverter("Asia/Taipei")
+
+	result, err := conv.Convert("1642781234", nil)
+	if err != nil {
+		t.Fatalf("Convert failed: %v", err)
+	}
+	if result.FileTime != 132872548340000000 {
+		t.Errorf("FileTime = %d, want 132872548340000000", result.FileTime)
+	}
+	if result.DotNetTicks != 637783780340000000 {
+		t.Errorf("DotNetTicks = %d, want 637783780340000000", result.DotNetTicks)
+	}
+
+	// 往返轉換
+	back, err := conv.Convert("132872548340000000", nil)
+	if err != nil {
+		t.Fatalf("Convert FILETIME failed: %v", err)
+	}
+	if back.UnixSeconds != 1642781234 || back.DetectedFormat != "Windows FILETIME" {
+		t.Errorf("FILETIME round trip = %d (%s)", back.UnixSeconds, back.DetectedFormat)
+	}
+}
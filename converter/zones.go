@@ -0,0 +1,61 @@
+package converter
+
+import (
+	"strings"
+	"time"
+)
+
+// ZoneTime 同一時間點在特定時區的表示
+type ZoneTime struct {
+	Zone          string `json:"zone"`
+	DateTime      string `json:"datetime"`
+	RFC3339       string `json:"rfc3339"`
+	Offset        string `json:"offset"`
+	OffsetSeconds int    `json:"offset_seconds"`
+	Abbreviation  string `json:"abbreviation"`
+	IsDST         bool   `json:"is_dst"`
+	Timezone      string `json:"timezone"`
+}
+
+// LoadLocations 載入多個時區，每個項目可以是逗號分隔的清單 (如 "UTC,Asia/Taipei")
+func LoadLocations(zones []string) ([]*time.Location, error) {
+	var locs []*time.Location
+	for _, item := range zones {
+		for _, name := range strings.Split(item, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+			loc, err := LoadLocation(name)
+			if err != nil {
+				return nil, err
+			}
+			locs = append(locs, loc)
+		}
+	}
+	return locs, nil
+}
+
+// InZones 取得時間在各時區的表示，locs 為空時回傳 nil
+func (c *Converter) InZones(t time.Time, locs []*time.Location) []ZoneTime {
+	if len(locs) == 0 {
+		return nil
+	}
+
+	zones := make([]ZoneTime, 0, len(locs))
+	for _, loc := range locs {
+		local := t.In(loc)
+		abbr, offset := local.Zone()
+		zones = append(zones, ZoneTime{
+			Zone:          loc.String(),
+			DateTime:      local.Format("2006-01-02 15:04:05"),
+			RFC3339:       local.Format(time.RFC3339Nano),
+			Offset:        formatOffset(offset),
+			OffsetSeconds: offset,
+			Abbreviation:  abbr,
+			IsDST:         local.IsDST(),
+			Timezone:      c.getTimezoneInfo(local),
+		})
+	}
+	return zones
+}
@@ -0,0 +1,62 @@
+// Package converter 提供時間戳轉換功能的測試
+package converter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoadLocations(t *testing.T) {
+	locs, err := LoadLocations([]string{"UTC,Asia/Taipei", " America/New_York ", ""})
+	if err != nil {
+		t.Fatalf("LoadLocations error = %v", err)
+	}
+	want := []string{"UTC", "Asia/Taipei", "America/New_York"}
+	if len(locs) != len(want) {
+		t.Fatalf("LoadLocations returned %d zones, want %d", len(locs), len(want))
+	}
+	for i, loc := range locs {
+		if loc.String() != want[i] {
+			t.Errorf("zone %d = %q, want %q", i, loc.String(), want[i])
+		}
+	}
+
+	if _, err := LoadLocations([]string{"UTC,Invalid/Zone"}); err == nil {
+		t.Error("LoadLocations with invalid zone expected error")
+	}
+}
+
+func TestConvertZones(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+	locs, _ := LoadLocations([]string{"UTC", "Asia/Taipei", "America/New_York"})
+	conv.Zones = locs
+
+	// 2022-07-05 05:46:40 UTC，紐約為夏令時間
+	result, err := conv.Convert("1657000000", nil)
+	if err != nil {
+		t.Fatalf("Convert failed: %v", err)
+	}
+
+	want := []ZoneTime{
+		{Zone: "UTC", DateTime: "2022-07-05 05:46:40", Offset: "+00:00", OffsetSeconds: 0, Abbreviation: "UTC", IsDST: false},
+		{Zone: "Asia/Taipei", DateTime: "2022-07-05 13:46:40", Offset: "+08:00", OffsetSeconds: 28800, Abbreviation: "CST", IsDST: false},
+		{Zone: "America/New_York", DateTime: "2022-07-05 01:46:40", Offset: "-04:00", OffsetSeconds: -14400, Abbreviation: "EDT", IsDST: true},
+	}
+	if len(result.Zones) != len(want) {
+		t.Fatalf("Zones has %d entries, want %d", len(result.Zones), len(want))
+	}
+	for i, z := range result.Zones {
+		w := want[i]
+		if z.Zone != w.Zone || z.DateTime != w.DateTime || z.Offset != w.Offset ||
+			z.OffsetSeconds != w.OffsetSeconds || z.Abbreviation != w.Abbreviation || z.IsDST != w.IsDST {
+			t.Errorf("zone %d = %+v, want %+v", i, z, w)
+		}
+	}
+}
+
+func TestInZonesEmpty(t *testing.T) {
+	conv, _ := NewConverter("UTC")
+	if zones := conv.InZones(time.Now(), nil); zones != nil {
+		t.Errorf("InZones(nil) = %v, want nil", zones)
+	}
+}
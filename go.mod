@@ -1,4 +1,4 @@
-module timestamp
+module github.com/vincent119/timesamp
 
 go 1.23.0
 
@@ -7,10 +7,8 @@ toolchain go1.24.5
 require (
 	github.com/nicksnyder/go-i18n/v2 v2.6.0
 	github.com/spf13/cobra v1.8.0
+	github.com/spf13/pflag v1.0.5
 	golang.org/x/text v0.23.0
 )
 
-require (
-	github.com/inconshreveable/mousetrap v1.1.0 // indirect
-	github.com/spf13/pflag v1.0.5 // indirect
-)
+require github.com/inconshreveable/mousetrap v1.1.0 // indirect
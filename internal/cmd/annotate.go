@@ -0,0 +1,112 @@
+// Package cmd contains the command-line interface for the timestamp tool
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/vincent119/timesamp/converter"
+	"github.com/vincent119/timesamp/internal/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	annotateReplace bool
+)
+
+// annotateCmd 在任意文字中尋找並標註時間戳
+var annotateCmd = &cobra.Command{
+	Use:   "annotate [file...]",
+	Short: "Annotate timestamps embedded in free-form text",
+	Long: `Scan free-form text (log lines, JSON, stack traces) for timestamps and
+append the human-readable form in the selected timezone.
+
+Recognised timestamps: Unix seconds/milliseconds/microseconds/nanoseconds
+(10/13/16/19 digits), Windows FILETIME and .NET ticks (18 digits),
+RFC3339, "YYYY-MM-DD HH:MM:SS" and "YYYY-MM-DD".
+Input is read from the given files, or from stdin when no file is given.
+
+Examples:
+  tail -f app.log | timestamp annotate -z Asia/Taipei
+  timestamp annotate --replace -o rfc3339 app.log`,
+	RunE: annotateText,
+}
+
+func init() {
+	rootCmd.AddCommand(annotateCmd)
+	annotateCmd.Flags().BoolVar(&annotateReplace, "replace", false,
+		"Replace timestamps instead of appending the converted value")
+
+	// 在 PersistentPreRun 後更新 annotate 命令描述
+	originalPreRun := annotateCmd.PreRun
+	annotateCmd.PreRun = func(cmd *cobra.Command, args []string) {
+		annotateCmd.Short = i18n.T("cmd.annotate.short")
+		annotateCmd.Long = i18n.T("cmd.annotate.long")
+		if flag := annotateCmd.Flags().Lookup("replace"); flag != nil {
+			flag.Usage = i18n.T("flag.replace")
+		}
+		if originalPreRun != nil {
+			originalPreRun(cmd, args)
+		}
+	}
+}
+
+// annotateText 標註 stdin 或檔案中的時間戳
+func annotateText(cmd *cobra.Command, args []string) error {
+	conv, err := newConverter()
+	if err != nil {
+		return err
+	}
+
+	out := bufio.NewWriter(cmd.OutOrStdout())
+	defer out.Flush()
+
+	if len(args) == 0 {
+		return annotateStream(conv, cmd.InOrStdin(), out)
+	}
+
+	for _, path := range args {
+		file, err := os.Open(path)
+		if err != nil {
+			return wrapLocalizedError("error.open.file", err)
+		}
+		err = annotateStream(conv, file, out)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// annotateStream 逐行改寫輸入，保留原始內容並標註時間戳
+func annotateStream(conv *converter.Converter, r io.Reader, out *bufio.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize+1)
+
+	for scanner.Scan() {
+		line := conv.ReplaceTimestamps(scanner.Text(), func(m converter.Match) string {
+			result, err := conv.Convert(m.Text, &m.Format)
+			if err != nil {
+				return m.Text
+			}
+			if annotateReplace {
+				return formatConverted(result)
+			}
+			return fmt.Sprintf("%s [%s]", m.Text, formatConverted(result))
+		})
+		out.WriteString(line)
+		out.WriteByte('\n')
+		// 即時輸出，讓 tail -f 的內容可以立刻看到
+		if err := out.Flush(); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return wrapLocalizedError("error.read.input", err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vincent119/timesamp/internal/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+// runAnnotateCommand 以 UTC 與指定的輸出格式執行 annotate，結束後還原所有 flag 與語言
+func runAnnotateCommand(t *testing.T, stdin string, args []string, format string, replace bool) (string, error) {
+	t.Helper()
+
+	savedOutput, savedZones, savedReplace := outputFormat, timezones, annotateReplace
+	savedLang := i18n.GetCurrentLanguage()
+	t.Cleanup(func() {
+		outputFormat, timezones, annotateReplace = savedOutput, savedZones, savedReplace
+		i18n.SetLanguage(savedLang)
+	})
+
+	outputFormat, timezones, annotateReplace = format, []string{"UTC"}, replace
+	i18n.SetLanguage("en")
+
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetIn(strings.NewReader(stdin))
+	cmd.SetOut(&stdout)
+	err := annotateText(cmd, args)
+	return stdout.String(), err
+}
+
+func TestAnnotateCommand(t *testing.T) {
+	input := `{"ts": 1642781234000, "msg": "login"}
+no timestamps here
+2022-01-21 16:07:14 ERROR retry after 1642781294
+`
+
+	tests := []struct {
+		name    string
+		format  string
+		replace bool
+		want    string
+	}{
+		{
+			name:   "append",
+			format: "rfc3339",
+			want: `{"ts": 1642781234000 [2022-01-21T16:07:14Z], "msg": "login"}
+no timestamps here
+2022-01-21 16:07:14 [2022-01-21T16:07:14Z] ERROR retry after 1642781294 [2022-01-21T16:08:14Z]
+`,
+		},
+		{
+			name:    "replace",
+			format:  "unix",
+			replace: true,
+			want: `{"ts": 1642781234, "msg": "login"}
+no timestamps here
+1642781234 ERROR retry after 1642781294
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runAnnotateCommand(t, input, nil, tt.format, tt.replace)
+			if err != nil {
+				t.Fatalf("annotateText() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("annotateText() output:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnnotateFiles(t *testing.T) {
+	first := writeBatchFile(t, "first.log", "started 1642781234\n")
+	second := writeBatchFile(t, "second.log", "stopped 1642781294")
+
+	got, err := runAnnotateCommand(t, "ignored 1642781234\n", []string{first, second}, "datetime", false)
+	if err != nil {
+		t.Fatalf("annotateText() error = %v", err)
+	}
+	if want := "started 1642781234 [2022-01-21 16:07:14]\nstopped 1642781294 [2022-01-21 16:08:14]\n"; got != want {
+		t.Errorf("annotateText() = %q, want %q", got, want)
+	}
+
+	_, err = runAnnotateCommand(t, "", []string{filepath.Join(t.TempDir(), "missing.log")}, "datetime", false)
+	if !errors.Is(err, fs.ErrNotExist) || !strings.HasPrefix(err.Error(), "failed to open file: ") {
+		t.Errorf("missing file error = %v", err)
+	}
+}
+
+func TestAnnotateLineLimit(t *testing.T) {
+	long := strings.Repeat("x", maxLineSize+1)
+	_, err := runAnnotateCommand(t, long+"\n", []string{writeBatchFile(t, "long.log", long)}, "datetime", false)
+	if err == nil || !strings.HasSuffix(err.Error(), ": failed to read input: line longer than 1048576 bytes") {
+		t.Errorf("annotateText() error = %v", err)
+	}
+}
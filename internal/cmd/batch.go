@@ -0,0 +1,138 @@
+// Package cmd contains the command-line interface for the timestamp tool
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/vincent119/timesamp/converter"
+	"github.com/vincent119/timesamp/internal/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+// maxLineSize 批次模式下單行的最大長度
+const maxLineSize = 1024 * 1024
+
+// batchSource 批次輸入來源
+type batchSource struct {
+	name   string
+	reader io.Reader
+}
+
+// stdinIsPiped 判斷 stdin 是否來自管線或重新導向的檔案
+// CI、cron 與編輯器常以 /dev/null 或 socket 作為 stdin，此時不應等待輸入
+func stdinIsPiped() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return isPipeOrFile(info.Mode())
+}
+
+// isPipeOrFile 判斷檔案模式是否為具名或匿名管線，或一般檔案
+func isPipeOrFile(mode os.FileMode) bool {
+	return mode&os.ModeNamedPipe != 0 || mode.IsRegular()
+}
+
+// convertBatch 逐行轉換 stdin 或 --file 指定檔案中的時間戳
+func convertBatch(cmd *cobra.Command) error {
+	conv, err := newConverter()
+	if err != nil {
+		return err
+	}
+
+	var inputFmt *converter.TimestampFormat
+	if inputFormat != "" {
+		format, parseErr := parseInputFormat(inputFormat)
+		if parseErr != nil {
+			return newLocalizedError(parseErr)
+		}
+		inputFmt = &format
+	}
+
+	out := bufio.NewWriter(cmd.OutOrStdout())
+	defer out.Flush()
+
+	if len(inputFiles) == 0 {
+		return runBatch(conv, inputFmt, batchSource{name: "stdin", reader: cmd.InOrStdin()}, out, cmd.ErrOrStderr())
+	}
+
+	failed := 0
+	for _, path := range inputFiles {
+		file, err := os.Open(path)
+		if err != nil {
+			openErr := wrapLocalizedError("error.open.file", err)
+			if !continueOnErr {
+				return openErr
+			}
+			// 與單行的錯誤相同，回報後繼續處理其他檔案
+			out.Flush()
+			fmt.Fprintln(cmd.ErrOrStderr(), openErr)
+			failed++
+			continue
+		}
+		err = runBatch(conv, inputFmt, batchSource{name: path, reader: file}, out, cmd.ErrOrStderr())
+		file.Close()
+		if err != nil {
+			if !continueOnErr {
+				return err
+			}
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.New(i18n.TPlural("error.batch.files.failed", failed))
+	}
+	return nil
+}
+
+// runBatch 串流讀取單一來源，每行輸出一個轉換結果
+func runBatch(conv *converter.Converter, inputFmt *converter.TimestampFormat, src batchSource, out *bufio.Writer, errOut io.Writer) error {
+	scanner := bufio.NewScanner(src.reader)
+	// 緩衝區須同時容納換行字元，單行 (不含換行) 最長 maxLineSize
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize+1)
+
+	lineNo := 0
+	failed := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		result, err := conv.Convert(line, inputFmt)
+		if err != nil {
+			if !continueOnErr {
+				return fmt.Errorf("%s:%d: %w", src.name, lineNo, wrapLocalizedError("error.conversion.failed", err))
+			}
+			out.Flush()
+			fmt.Fprintf(errOut, "%s:%d: %s\n", src.name, lineNo, localizeError(i18n.Current(), err))
+			failed++
+			continue
+		}
+
+		if jsonOutput {
+			localizeResult(i18n.Current(), result)
+			jsonData, _ := json.Marshal(result)
+			out.Write(jsonData)
+			out.WriteByte('\n')
+		} else {
+			fmt.Fprintln(out, formatConverted(result))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%s:%d: %w", src.name, lineNo+1, wrapLocalizedError("error.read.input", err))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%s: %s", src.name, i18n.TPlural("error.batch.lines.failed", failed))
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vincent119/timesamp/internal/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+// runBatchCommand 以指定的 stdin、--file 與 --continue-on-error 執行批次模式
+// 輸出格式固定為 unix，結束後還原所有 flag 與語言
+func runBatchCommand(t *testing.T, stdin string, files []string, continueOnError bool) (string, string, error) {
+	t.Helper()
+
+	savedFiles, savedContinue := inputFiles, continueOnErr
+	savedInput, savedOutput, savedZones, savedJSON := inputFormat, outputFormat, timezones, jsonOutput
+	savedLang := i18n.GetCurrentLanguage()
+	t.Cleanup(func() {
+		inputFiles, continueOnErr = savedFiles, savedContinue
+		inputFormat, outputFormat, timezones, jsonOutput = savedInput, savedOutput, savedZones, savedJSON
+		i18n.SetLanguage(savedLang)
+	})
+
+	inputFiles, continueOnErr = files, continueOnError
+	inputFormat, outputFormat, timezones, jsonOutput = "", "unix", []string{"UTC"}, false
+	i18n.SetLanguage("en")
+
+	var stdout, stderr bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetIn(strings.NewReader(stdin))
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+	err := convertBatch(cmd)
+	return stdout.String(), stderr.String(), err
+}
+
+// writeBatchFile 在暫存目錄建立批次輸入檔
+func writeBatchFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestBatchStdin(t *testing.T) {
+	stdout, stderr, err := runBatchCommand(t, "1642781234\n\n  2022-01-21T16:07:14Z  \n1642781234000\n", nil, false)
+	if err != nil {
+		t.Fatalf("convertBatch() error = %v", err)
+	}
+	if want := "1642781234\n1642781234\n1642781234\n"; stdout != want {
+		t.Errorf("stdout = %q, want %q", stdout, want)
+	}
+	if stderr != "" {
+		t.Errorf("stderr = %q, want empty", stderr)
+	}
+}
+
+func TestBatchFiles(t *testing.T) {
+	first := writeBatchFile(t, "first.txt", "1642781234\n1642781235\n")
+	second := writeBatchFile(t, "second.txt", "2022-01-21T16:07:16Z")
+
+	stdout, _, err := runBatchCommand(t, "ignored\n", []string{first, second}, false)
+	if err != nil {
+		t.Fatalf("convertBatch() error = %v", err)
+	}
+	if want := "1642781234\n1642781235\n1642781236\n"; stdout != want {
+		t.Errorf("stdout = %q, want %q", stdout, want)
+	}
+}
+
+func TestBatchLinePrefix(t *testing.T) {
+	path := writeBatchFile(t, "input.txt", "1642781234\ngarbage\n1642781235\n")
+
+	stdout, _, err := runBatchCommand(t, "", []string{path}, false)
+	if err == nil {
+		t.Fatal("convertBatch() error = nil, want conversion error")
+	}
+	if want := path + ":2: conversion failed: unrecognised time format: garbage"; err.Error() != want {
+		t.Errorf("error = %q, want %q", err.Error(), want)
+	}
+	// 失敗前的結果已輸出，之後的行不再處理
+	if stdout != "1642781234\n" {
+		t.Errorf("stdout = %q, want only the first line", stdout)
+	}
+
+	_, _, err = runBatchCommand(t, "", []string{filepath.Join(t.TempDir(), "missing.txt")}, false)
+	if !errors.Is(err, fs.ErrNotExist) || !strings.HasPrefix(err.Error(), "failed to open file: ") {
+		t.Errorf("missing file error = %v", err)
+	}
+}
+
+func TestBatchContinueOnError(t *testing.T) {
+	stdout, stderr, err := runBatchCommand(t, "1642781234\ngarbage\n1642781235\nbogus\n", nil, true)
+	if err == nil || err.Error() != "stdin: 2 lines failed to convert" {
+		t.Errorf("error = %v, want a non-nil error reporting 2 failed lines", err)
+	}
+	if want := "1642781234\n1642781235\n"; stdout != want {
+		t.Errorf("stdout = %q, want %q", stdout, want)
+	}
+	if want := "stdin:2: unrecognised time format: garbage\nstdin:4: unrecognised time format: bogus\n"; stderr != want {
+		t.Errorf("stderr = %q, want %q", stderr, want)
+	}
+
+	good := writeBatchFile(t, "good.txt", "1642781234\n")
+	bad := writeBatchFile(t, "bad.txt", "garbage\n")
+	stdout, stderr, err = runBatchCommand(t, "", []string{bad, good, bad}, true)
+	if err == nil || err.Error() != "2 files could not be read or contained lines that failed to convert" {
+		t.Errorf("error = %v, want a non-nil error reporting 2 failed files", err)
+	}
+	if stdout != "1642781234\n" {
+		t.Errorf("stdout = %q, want the good file converted", stdout)
+	}
+	if strings.Count(stderr, bad+":1: ") != 2 {
+		t.Errorf("stderr = %q, want both failures prefixed with file:line", stderr)
+	}
+
+	// 無法開啟的檔案同樣只回報並繼續處理後續檔案
+	missing := filepath.Join(t.TempDir(), "missing.txt")
+	stdout, stderr, err = runBatchCommand(t, "", []string{missing, good}, true)
+	if err == nil || err.Error() != "1 file could not be read or contained lines that failed to convert" {
+		t.Errorf("error = %v, want a non-nil error reporting 1 failed file", err)
+	}
+	if stdout != "1642781234\n" {
+		t.Errorf("stdout = %q, want the good file converted", stdout)
+	}
+	if !strings.HasPrefix(stderr, "failed to open file: ") || !strings.Contains(stderr, missing) {
+		t.Errorf("stderr = %q, want the open error for %s", stderr, missing)
+	}
+}
+
+func TestIsPipeOrFile(t *testing.T) {
+	tests := []struct {
+		name string
+		mode fs.FileMode
+		want bool
+	}{
+		{"pipe", fs.ModeNamedPipe, true},
+		{"regular file", 0o644, true},
+		{"terminal", fs.ModeDevice | fs.ModeCharDevice, false},
+		{"socket", fs.ModeSocket, false},
+		{"directory", fs.ModeDir, false},
+	}
+	for _, tt := range tests {
+		if got := isPipeOrFile(tt.mode); got != tt.want {
+			t.Errorf("isPipeOrFile(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBatchLineLimit(t *testing.T) {
+	// 恰好 1 MB 的行仍可轉換
+	padded := strings.Repeat(" ", maxLineSize-len("1642781234")) + "1642781234"
+	stdout, _, err := runBatchCommand(t, padded+"\n", nil, false)
+	if err != nil {
+		t.Fatalf("convertBatch() error = %v", err)
+	}
+	if stdout != "1642781234\n" {
+		t.Errorf("stdout = %q", stdout)
+	}
+
+	_, _, err = runBatchCommand(t, "1642781234\n"+padded+" \n", nil, false)
+	if !errors.Is(err, bufio.ErrTooLong) {
+		t.Fatalf("convertBatch() error = %v, want bufio.ErrTooLong", err)
+	}
+	if want := "stdin:2: failed to read input: line longer than 1048576 bytes"; err.Error() != want {
+		t.Errorf("error = %q, want %q", err.Error(), want)
+	}
+}
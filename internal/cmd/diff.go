@@ -0,0 +1,129 @@
+// Package cmd contains the command-line interface for the timestamp tool
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/vincent119/timesamp/converter"
+	"github.com/vincent119/timesamp/internal/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+// diffCmd 計算兩個時間點之間的間隔
+var diffCmd = &cobra.Command{
+	Use:   "diff <from> <to>",
+	Short: "Compute the interval between two instants",
+	Long: `Compute the interval from <from> to <to>. Both inputs are auto-detected
+(or parsed with --input-format) and may use different formats.
+
+The result includes the exact duration in seconds, milliseconds and
+nanoseconds, a calendar breakdown in the selected timezone, an ISO 8601
+duration and a human-readable description.
+
+Examples:
+  timestamp diff 1642781234 "2022-01-21 18:00:00"
+  timestamp diff 2024-01-31 2024-03-01 -z Asia/Taipei --json`,
+	Args: cobra.ExactArgs(2),
+	RunE: diffTimestamps,
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+	diffCmd.Flags().BoolVarP(&jsonOutput, "json", "j", false, "Output in JSON format")
+
+	// 在 PersistentPreRun 後更新 diff 命令描述
+	originalPreRun := diffCmd.PreRun
+	diffCmd.PreRun = func(cmd *cobra.Command, args []string) {
+		diffCmd.Short = i18n.T("cmd.diff.short")
+		diffCmd.Long = i18n.T("cmd.diff.long")
+		if flag := diffCmd.Flags().Lookup("json"); flag != nil {
+			flag.Usage = i18n.T("flag.json")
+		}
+		if originalPreRun != nil {
+			originalPreRun(cmd, args)
+		}
+	}
+}
+
+// diffTimestamps 計算並輸出兩個時間點的間隔
+func diffTimestamps(cmd *cobra.Command, args []string) error {
+	conv, err := newConverter()
+	if err != nil {
+		return err
+	}
+
+	var inputFmt *converter.TimestampFormat
+	if inputFormat != "" {
+		format, parseErr := parseInputFormat(inputFormat)
+		if parseErr != nil {
+			return newLocalizedError(parseErr)
+		}
+		inputFmt = &format
+	}
+
+	result, err := conv.Diff(args[0], args[1], inputFmt)
+	if err != nil {
+		return wrapLocalizedError("error.diff.failed", err)
+	}
+
+	tr := i18n.Current()
+	localizeDiff(tr, result)
+
+	if jsonOutput {
+		jsonData, _ := json.MarshalIndent(result, "", "  ")
+		fmt.Println(string(jsonData))
+		return nil
+	}
+
+	cal := result.Calendar
+	fields := [][2]string{
+		{tr.T("label.from"), fmt.Sprintf("%s (%s)", result.From, result.FromRFC3339)},
+		{tr.T("label.to"), fmt.Sprintf("%s (%s)", result.To, result.ToRFC3339)},
+		{tr.T("label.total.seconds"), strconv.FormatFloat(result.TotalSeconds, 'f', -1, 64)},
+	}
+	// 間隔過長時毫秒或納秒總數無法以 int64 表示，省略該欄位
+	if result.TotalMillis != nil {
+		fields = append(fields, [2]string{tr.T("label.total.milliseconds"), strconv.FormatInt(*result.TotalMillis, 10)})
+	}
+	if result.TotalNanos != nil {
+		fields = append(fields, [2]string{tr.T("label.total.nanoseconds"), strconv.FormatInt(*result.TotalNanos, 10)})
+	}
+	return writeFields(os.Stdout, append(fields, [][2]string{
+		{tr.T("label.calendar"), tr.T("diff.calendar", map[string]interface{}{
+			"Years":  cal.Years,
+			"Months": cal.Months,
+			"Days":   cal.Days,
+			"Clock":  fmt.Sprintf("%02d:%02d:%02d.%09d", cal.Hours, cal.Minutes, cal.Seconds, cal.Nanoseconds),
+		})},
+		{tr.T("label.iso8601"), result.ISO8601},
+		{tr.T("label.humanized"), result.Humanized},
+		{tr.T("label.timezone"), result.Timezone},
+	}...))
+}
+
+// localizeDiff 將間隔的描述翻譯為翻譯器的語言
+func localizeDiff(tr *i18n.Translator, result *converter.DiffResult) {
+	result.Humanized = humanizeDuration(tr, result.Calendar)
+}
+
+// humanizeDuration 以翻譯器的語言描述日曆間隔，如 "1 month, 1 day"
+func humanizeDuration(tr *i18n.Translator, d converter.CalendarDuration) string {
+	parts := d.Parts()
+	if len(parts) == 0 {
+		if d.Nanoseconds != 0 {
+			return tr.T("duration.less.than.second")
+		}
+		return tr.TPlural("duration.second", 0)
+	}
+
+	words := make([]string, len(parts))
+	for i, p := range parts {
+		words[i] = tr.TPlural("duration."+p.Unit, p.Value)
+	}
+	return strings.Join(words, tr.T("duration.separator"))
+}
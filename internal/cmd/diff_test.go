@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/vincent119/timesamp/converter"
+	"github.com/vincent119/timesamp/internal/i18n"
+)
+
+func TestHumanizeDuration(t *testing.T) {
+	monthDay := converter.CalendarDuration{Months: 1, Days: 1}
+	mixed := converter.CalendarDuration{Years: 2, Hours: 1, Seconds: 30}
+
+	tests := []struct {
+		lang string
+		d    converter.CalendarDuration
+		want string
+	}{
+		{"en", monthDay, "1 month, 1 day"},
+		{"en", mixed, "2 years, 1 hour, 30 seconds"},
+		{"en", converter.CalendarDuration{}, "0 seconds"},
+		{"en", converter.CalendarDuration{Nanoseconds: 5}, "less than a second"},
+		{"ja", monthDay, "1か月 1日"},
+		{"ja", converter.CalendarDuration{Nanoseconds: 5}, "1秒未満"},
+		{"zh-TW", mixed, "2 年 1 小時 30 秒"},
+		{"zh-CN", monthDay, "1 个月 1 天"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang+" "+tt.want, func(t *testing.T) {
+			if got := humanizeDuration(i18n.For(tt.lang), tt.d); got != tt.want {
+				t.Errorf("humanizeDuration() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/vincent119/timesamp/converter"
+	"github.com/vincent119/timesamp/internal/i18n"
+)
+
+// --output-format 的錯誤種類，以 converter.InputError 的 Kind 表示
+var (
+	errEmptyOutputLayout   = errors.New("empty Go layout in output format")
+	errEmptyOutputStrftime = errors.New("empty strftime pattern in output format")
+)
+
+// inputErrorMessages converter.InputError 各種類對應的訊息 ID
+// 訊息模板可使用 {{.Value}}、{{.Type}} 與 {{.Supported}}
+var inputErrorMessages = []struct {
+	kind error
+	id   string
+}{
+	{converter.ErrUnsupportedFormat, "error.input.unsupported.format"},
+	{converter.ErrInvalidNumber, "error.input.invalid.number"},
+	{converter.ErrInvalidDate, "error.input.invalid.date"},
+	{converter.ErrInvalidClock, "error.input.invalid.clock"},
+	{converter.ErrInvalidZoneOffset, "error.input.invalid.zone.offset"},
+	{converter.ErrUnknownZone, "error.input.unknown.zone"},
+	{converter.ErrPatternMismatch, "error.input.pattern.mismatch"},
+	{converter.ErrYearInference, "error.input.year.inference"},
+	{converter.ErrUnknownPhrase, "error.input.unknown.phrase"},
+	{converter.ErrUnsupportedUnit, "error.input.unsupported.unit"},
+	{converter.ErrUnexpectedText, "error.input.unexpected.text"},
+	{converter.ErrFractionalUnit, "error.input.fractional.unit"},
+	{converter.ErrRepeatedUnit, "error.input.repeated.unit"},
+	{converter.ErrInvalidID, "error.input.invalid.id"},
+	{converter.ErrNoTimestamp, "error.input.no.timestamp"},
+	{converter.ErrUnsupportedIDType, "error.input.unsupported.id.type"},
+	{converter.ErrOutOfRange, "error.input.out.of.range"},
+	{converter.ErrInvalidLayout, "error.input.invalid.layout"},
+	{converter.ErrUnsupportedDirective, "error.input.unsupported.directive"},
+	{errEmptyOutputLayout, "error.output.empty.layout"},
+	{errEmptyOutputStrftime, "error.output.empty.strftime"},
+}
+
+// localizedError 已翻譯為目前語言的錯誤，保留原始錯誤供 errors.Is 與 errors.As 判斷
+type localizedError struct {
+	msg string
+	err error
+}
+
+func (e *localizedError) Error() string {
+	return e.msg
+}
+
+func (e *localizedError) Unwrap() error {
+	return e.err
+}
+
+// newLocalizedError 將轉換器的錯誤翻譯為目前語言
+func newLocalizedError(err error) error {
+	return &localizedError{msg: localizeError(i18n.Current(), err), err: err}
+}
+
+// wrapLocalizedError 以訊息 ID 的翻譯作為前綴，包裝並翻譯轉換器的錯誤
+func wrapLocalizedError(messageID string, err error, templateData ...map[string]interface{}) error {
+	tr := i18n.Current()
+	return &localizedError{msg: tr.T(messageID, templateData...) + ": " + localizeError(tr, err), err: err}
+}
+
+// localizeError 依錯誤的型別組成翻譯器語言的訊息，無法辨識的錯誤回傳原本的訊息
+func localizeError(tr *i18n.Translator, err error) string {
+	var (
+		ambiguousErr *converter.AmbiguousError
+		formatErr    *converter.FormatError
+		parseErr     *converter.ParseError
+		offsetErr    *converter.OffsetError
+		timezoneErr  *converter.TimezoneError
+		inputErr     *converter.InputError
+		timeErr      *time.ParseError
+		localErr     *localizedError
+	)
+
+	switch {
+	case errors.As(err, &localErr):
+		return localErr.msg
+	case errors.As(err, &ambiguousErr):
+		return localizeAmbiguousError(tr, ambiguousErr)
+	case errors.As(err, &formatErr):
+		if formatErr.Numeric {
+			return tr.T("error.unknown.numeric", map[string]interface{}{"Input": formatErr.Input})
+		}
+		return tr.T("error.unknown.format", map[string]interface{}{"Input": formatErr.Input})
+	case errors.As(err, &parseErr):
+		if errors.Is(parseErr.Err, converter.ErrUnsupportedFormat) {
+			return tr.T("error.unsupported.format", map[string]interface{}{"Format": formatLabel(tr, parseErr.Format)})
+		}
+		return tr.T("error.parse", map[string]interface{}{
+			"Input":  parseErr.Input,
+			"Format": formatLabel(tr, parseErr.Format),
+			"Reason": localizeError(tr, parseErr.Err),
+		})
+	case errors.As(err, &offsetErr):
+		return tr.T("error.time.offset", map[string]interface{}{
+			"Input":  offsetErr.Input,
+			"Reason": localizeError(tr, offsetErr.Err),
+		})
+	case errors.As(err, &timezoneErr):
+		return tr.T("error.timezone", map[string]interface{}{"Name": timezoneErr.Name})
+	case errors.As(err, &inputErr):
+		return localizeInputError(tr, inputErr)
+	case errors.Is(err, bufio.ErrTooLong):
+		return tr.T("error.line.too.long", map[string]interface{}{"Limit": maxLineSize})
+	case errors.As(err, &timeErr):
+		// Message 非空時為數值超出範圍 (如月份 13)，否則為某個版面元素不符
+		if timeErr.Message != "" {
+			return tr.T("error.time.range", map[string]interface{}{"Value": timeErr.Value})
+		}
+		return tr.T("error.time.element", map[string]interface{}{
+			"Value":  timeErr.ValueElem,
+			"Layout": timeErr.LayoutElem,
+		})
+	}
+	return err.Error()
+}
+
+// localizeInputError 翻譯單一值的錯誤，可定位時附上從 1 起算的欄位
+func localizeInputError(tr *i18n.Translator, err *converter.InputError) string {
+	msg := err.Error()
+	for _, m := range inputErrorMessages {
+		if errors.Is(err.Kind, m.kind) {
+			msg = tr.T(m.id, map[string]interface{}{
+				"Value":     err.Value,
+				"Type":      err.Type,
+				"Supported": strings.Join(converter.IDRangeTypes, ", "),
+			})
+			break
+		}
+	}
+	if err.Position < 0 {
+		return msg
+	}
+	return tr.T("error.position", map[string]interface{}{"Message": msg, "Column": err.Position + 1})
+}
+
+// localizeAmbiguousError 翻譯 Strict 模式下無法確定的數字時間戳，列出各個合理解讀
+func localizeAmbiguousError(tr *i18n.Translator, err *converter.AmbiguousError) string {
+	if len(err.Candidates) == 0 {
+		return tr.T("error.implausible", map[string]interface{}{"Input": err.Input})
+	}
+	names := make([]string, len(err.Candidates))
+	for i, c := range err.Candidates {
+		names[i] = formatLabel(tr, c.Format) + " (" + c.Time + ")"
+	}
+	return tr.T("error.ambiguous", map[string]interface{}{
+		"Input":      err.Input,
+		"Candidates": strings.Join(names, ", "),
+	})
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/vincent119/timesamp/converter"
+	"github.com/vincent119/timesamp/internal/i18n"
+)
+
+func TestLocalizeError(t *testing.T) {
+	conv, _ := converter.NewConverter("UTC")
+	_, parseErr := conv.Parse("Fri, 21 Jan 2022 16:07:14 XYZ", converter.RFC1123())
+	_, offsetErr := converter.ParseOffset("2h30x")
+	_, repeatedErr := converter.ParseOffset("1h2h")
+	_, overflowErr := converter.ParseOffset("+9999999999h")
+	_, rangeErr := conv.IDRange("ksuid", time.Now())
+	_, formatErr := conv.DetectFormat("garbage")
+
+	tests := []struct {
+		name string
+		lang string
+		err  error
+		want string
+	}{
+		{"parse", "en", parseErr, "cannot parse Fri, 21 Jan 2022 16:07:14 XYZ as RFC 1123/HTTP date: unrecognised time zone abbreviation: XYZ"},
+		{"parse zh-TW", "zh-TW", parseErr, "無法以 RFC 1123/HTTP 日期 解析 Fri, 21 Jan 2022 16:07:14 XYZ: 無法辨識的時區縮寫: XYZ"},
+		{"offset", "en", offsetErr, `invalid time offset 2h30x (e.g. 1d, 2w, 1d6h, 1.5h, P1DT2H): unexpected text "30x" (column 3)`},
+		{"offset ja", "ja", offsetErr, `無効な時間オフセット 2h30x (例: 1d、2w、1d6h、1.5h、P1DT2H): 解析できない文字列 "30x" (3 文字目)`},
+		{"repeated unit", "en", repeatedErr, "invalid time offset 1h2h (e.g. 1d, 2w, 1d6h, 1.5h, P1DT2H): time unit used more than once: 2h (column 3)"},
+		{"offset overflow zh-TW", "zh-TW", overflowErr, "無效的時間偏移 +9999999999h (格式如 1d、2w、1d6h、1.5h、P1DT2H): 9999999999h 超出 time.Duration 的範圍"},
+		{"id type", "en", rangeErr, "unsupported ID type: ksuid (supported: ulid, uuid7, snowflake, objectid)"},
+		{"format", "zh-CN", formatErr, "无法识别的时间格式: garbage"},
+		{"wrapped", "en", fmt.Errorf("line 3: %w", formatErr), "unrecognised time format: garbage"},
+		{"other", "ja", fmt.Errorf("disk full"), "disk full"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := localizeError(i18n.For(tt.lang), tt.err); got != tt.want {
+				t.Errorf("localizeError() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
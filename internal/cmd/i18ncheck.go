@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/vincent119/timesamp/internal/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+// i18nCmd 翻譯檔維護工具，供開發與 CI 使用，不列在說明中
+var i18nCmd = &cobra.Command{
+	Use:    "i18n",
+	Short:  "Locale catalog maintenance tools",
+	Long:   "Locale catalog maintenance tools for translators and CI.",
+	Hidden: true,
+}
+
+// i18nCheckCmd 檢查翻譯檔，發現問題時以非零狀態碼結束
+var i18nCheckCmd = &cobra.Command{
+	Use:   "check [locales-dir]",
+	Short: "Validate the locale catalogs",
+	Long: `Validate the locale catalogs against English.
+
+Reports unparsable files, stray files, duplicate message IDs, IDs missing
+from or not present in English, template syntax errors and template
+variables that differ from English. Without an argument the catalogs built
+into the binary are checked; pass a locales directory (such as
+internal/i18n/locales) to check files before building.
+
+Exits with a non-zero status when any problem is found.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: checkCatalogs,
+}
+
+func init() {
+	rootCmd.AddCommand(i18nCmd)
+	i18nCmd.AddCommand(i18nCheckCmd)
+
+	// 在 PersistentPreRun 後更新 i18n check 命令描述
+	originalPreRun := i18nCheckCmd.PreRun
+	i18nCheckCmd.PreRun = func(cmd *cobra.Command, args []string) {
+		i18nCmd.Short = i18n.T("cmd.i18n.short")
+		i18nCmd.Long = i18n.T("cmd.i18n.long")
+		i18nCheckCmd.Short = i18n.T("cmd.i18n.check.short")
+		i18nCheckCmd.Long = i18n.T("cmd.i18n.check.long")
+		if originalPreRun != nil {
+			originalPreRun(cmd, args)
+		}
+	}
+}
+
+// checkCatalogs 檢查內嵌或指定目錄中的翻譯檔並列出問題
+func checkCatalogs(cmd *cobra.Command, args []string) error {
+	var problems []i18n.Problem
+	if len(args) == 1 {
+		if _, err := os.Stat(args[0]); err != nil {
+			return err
+		}
+		problems = i18n.CheckCatalogsFS(os.DirFS(args[0]))
+	} else {
+		problems = i18n.CheckCatalogs()
+	}
+
+	tr := i18n.Current()
+	out := cmd.OutOrStdout()
+	if len(problems) == 0 {
+		fmt.Fprintln(out, tr.T("i18n.check.ok"))
+		return nil
+	}
+	for _, p := range problems {
+		fmt.Fprintln(out, localizeProblem(tr, p))
+	}
+	return errors.New(tr.TPlural("error.i18n.check", len(problems)))
+}
+
+// problemMessages i18n.Problem 各種類對應的訊息 ID
+// 訊息模板可使用 {{.File}}、{{.ID}}、{{.Detail}}、{{.Want}} 與 {{.Got}}
+var problemMessages = map[i18n.ProblemKind]string{
+	i18n.ProblemUnparsable:     "i18n.problem.unparsable",
+	i18n.ProblemMissingFile:    "i18n.problem.missing.file",
+	i18n.ProblemUnexpectedFile: "i18n.problem.unexpected.file",
+	i18n.ProblemDuplicate:      "i18n.problem.duplicate",
+	i18n.ProblemMissing:        "i18n.problem.missing",
+	i18n.ProblemExtra:          "i18n.problem.extra",
+	i18n.ProblemTemplate:       "i18n.problem.template",
+	i18n.ProblemVariables:      "i18n.problem.variables",
+}
+
+// localizeProblem 將翻譯檔的問題翻譯為翻譯器的語言，無法辨識的種類回傳預設訊息
+func localizeProblem(tr *i18n.Translator, p i18n.Problem) string {
+	id, ok := problemMessages[p.Kind]
+	if !ok {
+		return p.String()
+	}
+	return tr.T(id, map[string]interface{}{
+		"File":   p.File,
+		"ID":     p.ID,
+		"Detail": p.Detail,
+		"Want":   strings.Join(p.Want, ", "),
+		"Got":    strings.Join(p.Got, ", "),
+	})
+}
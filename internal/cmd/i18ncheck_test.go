@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vincent119/timesamp/internal/i18n"
+)
+
+func TestLocalizeProblem(t *testing.T) {
+	p := i18n.Problem{Kind: i18n.ProblemVariables, File: "ja/messages.json", ID: "date.long", Want: []string{"Day", "Year"}, Got: []string{"Year"}}
+
+	tests := []struct {
+		lang string
+		want string
+	}{
+		{"en", "ja/messages.json: date.long: template variables [Year] differ from English [Day, Year]"},
+		{"zh-TW", "ja/messages.json: date.long: 模板變數 [Year] 與英文 [Day, Year] 不同"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			if got := localizeProblem(i18n.For(tt.lang), p); got != tt.want {
+				t.Errorf("localizeProblem() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// 每種問題都必須有對應的翻譯
+	for kind, id := range problemMessages {
+		if got := localizeProblem(i18n.For("en"), i18n.Problem{Kind: kind}); strings.HasPrefix(got, id) {
+			t.Errorf("problem %s is not translated", kind)
+		}
+	}
+}
+
+func TestCheckCatalogsCommand(t *testing.T) {
+	dir := t.TempDir()
+	for _, lang := range i18n.SupportedLanguages {
+		data, err := os.ReadFile(filepath.Join("..", "i18n", "locales", lang, "messages.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(filepath.Join(dir, lang), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, lang, "messages.json"), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var out bytes.Buffer
+	i18nCheckCmd.SetOut(&out)
+	defer i18nCheckCmd.SetOut(nil)
+
+	if err := checkCatalogs(i18nCheckCmd, []string{dir}); err != nil {
+		t.Fatalf("checkCatalogs() error = %v, output:\n%s", err, out.String())
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "ja", "messages_backup.json"), []byte("[]"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	if err := checkCatalogs(i18nCheckCmd, []string{dir}); err == nil {
+		t.Fatal("checkCatalogs() error = nil, want an error for the stray file")
+	}
+	if !strings.Contains(out.String(), "ja/messages_backup.json") {
+		t.Errorf("output = %q, want the stray file listed", out.String())
+	}
+}
@@ -0,0 +1,142 @@
+// Package cmd contains the command-line interface for the timestamp tool
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/vincent119/timesamp/converter"
+	"github.com/vincent119/timesamp/internal/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	idRangeOffset string
+	idRangeTypes  []string
+)
+
+// idRangeCmd 產生某一時刻的最小與最大 ID，供以 ID 排序的範圍查詢使用
+var idRangeCmd = &cobra.Command{
+	Use:   "id-range [time]",
+	Short: "Generate the smallest and largest IDs for an instant",
+	Long: `Generate the smallest and largest ULID, UUIDv7, Snowflake and ObjectID that
+can be created at the given instant, for range scans such as "WHERE id >= X".
+
+The time is auto-detected (or parsed with --input-format) and defaults to now.
+--offset shifts it the same way as "timestamp now --offset". Each ID keeps
+its own precision: ULID, UUIDv7 and Snowflake use milliseconds, ObjectID
+uses seconds. Snowflakes use the --snowflake-epoch epoch.
+
+Examples:
+  timestamp id-range                            # IDs for the current instant
+  timestamp id-range --offset -1h               # IDs for one hour ago
+  timestamp id-range "2022-01-21 12:00:00" -z UTC
+  timestamp id-range 1642781234 --type ulid,objectid --json
+  timestamp id-range --snowflake-epoch discord --type snowflake`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: generateIDRange,
+}
+
+func init() {
+	rootCmd.AddCommand(idRangeCmd)
+	idRangeCmd.Flags().StringVar(&idRangeOffset, "offset", "", "Time offset (e.g., +1d, -1w, +2M, -1d6h, -PT15M, \"3 days ago\")")
+	idRangeCmd.Flags().StringSliceVar(&idRangeTypes, "type", nil, "ID types to generate (ulid, uuid7, snowflake, objectid; default: all)")
+	idRangeCmd.Flags().BoolVarP(&jsonOutput, "json", "j", false, "Output in JSON format")
+
+	// 在 PersistentPreRun 後更新 id-range 命令描述
+	originalPreRun := idRangeCmd.PreRun
+	idRangeCmd.PreRun = func(cmd *cobra.Command, args []string) {
+		idRangeCmd.Short = i18n.T("cmd.idrange.short")
+		idRangeCmd.Long = i18n.T("cmd.idrange.long")
+		if flag := idRangeCmd.Flags().Lookup("offset"); flag != nil {
+			flag.Usage = i18n.T("flag.offset")
+		}
+		if flag := idRangeCmd.Flags().Lookup("type"); flag != nil {
+			flag.Usage = i18n.T("flag.id.type")
+		}
+		if flag := idRangeCmd.Flags().Lookup("json"); flag != nil {
+			flag.Usage = i18n.T("flag.json")
+		}
+		if originalPreRun != nil {
+			originalPreRun(cmd, args)
+		}
+	}
+
+	idRangeCmd.RegisterFlagCompletionFunc("type", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return converter.IDRangeTypes, cobra.ShellCompDirectiveDefault
+	})
+}
+
+// idRangeResult id-range 的 JSON 輸出
+type idRangeResult struct {
+	Time   string              `json:"time"`
+	Ranges []converter.IDRange `json:"ranges"`
+}
+
+// generateIDRange 解析時間並輸出各 ID 類型的範圍
+func generateIDRange(cmd *cobra.Command, args []string) error {
+	conv, err := newConverter()
+	if err != nil {
+		return err
+	}
+
+	instant := time.Now().In(conv.Location)
+	if len(args) > 0 {
+		format, err := idRangeInputFormat(conv, args[0])
+		if err != nil {
+			return wrapLocalizedError("error.conversion.failed", err)
+		}
+		instant, err = conv.Parse(args[0], format)
+		if err != nil {
+			return wrapLocalizedError("error.conversion.failed", err)
+		}
+	}
+
+	if idRangeOffset != "" {
+		shifted, err := applyOffset(conv, instant, idRangeOffset)
+		if err != nil {
+			return newLocalizedError(err)
+		}
+		instant = shifted
+	}
+
+	types := idRangeTypes
+	if len(types) == 0 {
+		types = converter.IDRangeTypes
+	}
+	result := idRangeResult{Time: instant.Format(time.RFC3339Nano)}
+	for _, kind := range types {
+		r, err := conv.IDRange(kind, instant)
+		if err != nil {
+			return newLocalizedError(err)
+		}
+		result.Ranges = append(result.Ranges, *r)
+	}
+
+	if jsonOutput {
+		jsonData, _ := json.MarshalIndent(result, "", "  ")
+		fmt.Println(string(jsonData))
+		return nil
+	}
+
+	tr := i18n.Current()
+	writeFields(os.Stdout, [][2]string{{tr.T("label.time"), result.Time}})
+	fmt.Println()
+	table := &textTable{}
+	table.add(tr.T("label.type"), tr.T("label.min"), tr.T("label.max"))
+	for _, r := range result.Ranges {
+		table.add(r.Type, r.Min, r.Max)
+	}
+	return table.write(os.Stdout)
+}
+
+// idRangeInputFormat 取得 --input-format 指定的格式，未指定時自動偵測
+func idRangeInputFormat(conv *converter.Converter, input string) (converter.TimestampFormat, error) {
+	if inputFormat != "" {
+		return parseInputFormat(inputFormat)
+	}
+	return conv.DetectFormat(input)
+}
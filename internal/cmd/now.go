@@ -2,11 +2,10 @@
 package cmd
 
 import (
-	"fmt"
 	"time"
 
-	"timestamp/internal/converter"
-	"timestamp/internal/i18n"
+	"github.com/vincent119/timesamp/converter"
+	"github.com/vincent119/timesamp/internal/i18n"
 
 	"github.com/spf13/cobra"
 )
@@ -30,18 +29,27 @@ Supported relative time offsets:
 - M: months (e.g., +1M, -6M)
 - y: years (e.g., +1y, -2y)
 
+Components can be chained and fractional (e.g., -1d6h, +2w3d, 1.5h),
+and ISO 8601 durations are accepted (e.g., P1Y2M10DT2H30M, -PT15M).
+
+Natural-language phrases are also accepted, e.g. "yesterday", "tomorrow noon",
+"3 days ago", "in 2 weeks", "next monday 09:00", "last day of month", "start of quarter".
+
 Examples:
   timestamp now                  # Current time
   timestamp now --offset +1d     # Tomorrow same time
   timestamp now --offset -1d     # Yesterday same time
-  timestamp now --offset +1w     # Next week same time`,
+  timestamp now --offset +1w     # Next week same time
+  timestamp now --offset -1d6h   # 1 day 6 hours ago
+  timestamp now --offset -PT15M  # 15 minutes ago (ISO 8601)
+  timestamp now --offset "next friday 9am"`,
 	Args: cobra.NoArgs,
 	RunE: showCurrentTime,
 }
 
 func init() {
 	rootCmd.AddCommand(nowCmd)
-	nowCmd.Flags().StringVar(&timeOffset, "offset", "", "Time offset (e.g., +1d, -1w, +2M)")
+	nowCmd.Flags().StringVar(&timeOffset, "offset", "", "Time offset (e.g., +1d, -1w, +2M, -1d6h, -PT15M, \"3 days ago\")")
 
 	// 在 PersistentPreRun 後更新 now 命令描述
 	originalPreRun := nowCmd.PreRun
@@ -87,6 +95,10 @@ func init() {
 			"-6M\t6 months ago",
 			"+1y\t1 year later",
 			"-1y\t1 year ago",
+			"-1d6h\t1 day 6 hours ago",
+			"+1.5h\t90 minutes later",
+			"-PT15M\t15 minutes ago (ISO 8601)",
+			"P1DT12H\t1.5 days later (ISO 8601)",
 		}, cobra.ShellCompDirectiveDefault
 	})
 }
@@ -94,26 +106,29 @@ func init() {
 // showCurrentTime 顯示當前時間
 func showCurrentTime(cmd *cobra.Command, args []string) error {
 	// 建立轉換器
-	conv, err := converter.NewConverter(timezone)
+	conv, err := newConverter()
 	if err != nil {
-		return fmt.Errorf("failed to create converter: %v", err)
+		return err
 	}
 
 	now := time.Now().In(conv.Location)
+	if relativeTo == "" {
+		// 相對時間以命令執行的時刻為準
+		base := now
+		conv.Now = func() time.Time { return base }
+	}
 
 	// 處理時間偏移
 	if timeOffset != "" {
-		now, err = conv.AddTimeOffset(now, timeOffset)
-		if err != nil {
-			return fmt.Errorf(i18n.T("error.time.offset")+": %v", err)
+		shifted, offsetErr := applyOffset(conv, now, timeOffset)
+		if offsetErr != nil {
+			return newLocalizedError(offsetErr)
 		}
+		now = shifted
 	}
 
-	// 轉換時間
-	result, err := conv.Convert(fmt.Sprintf("%d", now.Unix()), nil)
-	if err != nil {
-		return fmt.Errorf("conversion failed: %v", err)
-	}
+	// 直接轉換時間，不經過數字時間戳的偵測
+	result := conv.ConvertTime(now)
 
 	if jsonOutput {
 		outputJSON(result)
@@ -123,3 +138,16 @@ func showCurrentTime(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// applyOffset 將偏移量套用到 base，無法解析為偏移量時改以自然語言 (如 "3 days ago") 解析
+// 兩者皆失敗時回傳偏移量的解析錯誤
+func applyOffset(conv *converter.Converter, base time.Time, offset string) (time.Time, error) {
+	shifted, offsetErr := conv.AddTimeOffset(base, offset)
+	if offsetErr == nil {
+		return shifted, nil
+	}
+	if natural, err := conv.ParseNatural(offset, base); err == nil {
+		return natural, nil
+	}
+	return base, offsetErr
+}
@@ -4,21 +4,35 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
-	"timestamp/internal/converter"
-	"timestamp/internal/i18n"
+	"github.com/vincent119/timesamp/converter"
+	"github.com/vincent119/timesamp/internal/i18n"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 var (
 	inputFormat    string
 	outputFormat   string
-	timezone       string
+	timezones      []string
 	inputTimestamp string
 	jsonOutput     bool
 	langFlag       string
+	inputFiles     []string
+	continueOnErr  bool
+	relativeTo     string
+	granularity    string
+	snowflakeEpoch string
+	strictDetect   bool
+	plausibleFrom  string
+	plausibleTo    string
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -37,17 +51,48 @@ var rootCmd = &cobra.Command{
 Examples:
   timestamp 1640995200                    # Unix timestamp conversion
   timestamp "2022-01-01 12:00:00"         # String format conversion
+  timestamp "next monday 09:00"           # Natural-language relative time
+  timestamp "Fri, 21 Jan 2022 16:07:14 GMT" # HTTP header date
+  timestamp -o iso-week 2024-032          # ISO 8601 ordinal date to week date
+  timestamp "02/Jan/2006:15:04:05 -0700"  # Apache/Nginx access log time
   timestamp -o rfc3339 1640995200         # Specify output format
-  timestamp -i "2006-01-02" "2022-01-01"  # Specify input format`,
+  timestamp -o 'strftime:%Y/%m/%d %H:%M' 1640995200   # strftime pattern
+  timestamp -o 'layout:Mon Jan _2 15:04' 1640995200   # Go reference layout
+  timestamp -z UTC,Asia/Taipei 1640995200 # Show several timezones at once
+  timestamp -i "2006-01-02" "2022-01-01"  # Specify input format (Go layout)
+  timestamp -i "%d/%m/%Y" "01/02/2022"    # Specify input format (strftime)
+  timestamp 01ARZ3NDEKTSV4RRFFQ69G5FAV    # Decode the time in a ULID
+  timestamp -i snowflake --snowflake-epoch discord 175928847299117063
+  cat times.log | timestamp               # Batch conversion from stdin
+  timestamp -f a.log -f b.log             # Batch conversion from files`,
 	Args: cobra.ArbitraryArgs,
+	// 錯誤統一由 Execute 輸出，避免重複顯示錯誤與用法說明
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// flag 解析完成後才能取得 --lang，在此套用語言設定
+		if langFlag != "" {
+			i18n.SetLanguage(langFlag)
+		}
+		if err := converter.ValidateGranularity(granularity); err != nil {
+			return wrapLocalizedError("error.invalid.flag", err, map[string]interface{}{"Flag": "--granularity"})
+		}
+		return validateOutputFormat(outputFormat)
+	},
 	Run: func(cmd *cobra.Command, args []string) {
-		if len(args) > 0 {
+		switch {
+		case len(args) > 0:
 			inputTimestamp = args[0]
 			if err := convertTimestamp(cmd, args); err != nil {
-				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				fmt.Fprintf(os.Stderr, "%s: %v\n", i18n.T("label.error"), err)
+				os.Exit(1)
+			}
+		case len(inputFiles) > 0 || stdinIsPiped():
+			if err := convertBatch(cmd); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", i18n.T("label.error"), err)
 				os.Exit(1)
 			}
-		} else {
+		default:
 			cmd.Help()
 		}
 	},
@@ -60,21 +105,114 @@ func Execute() {
 		i18n.SetLanguage(langFlag)
 	}
 
+	rootCmd.SetArgs(normalizeNegativeArgs(os.Args[1:]))
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		fmt.Fprintf(os.Stderr, "%s: %v\n", i18n.T("label.error"), err)
 		os.Exit(1)
 	}
 }
 
+// negativeNumberPattern 負數時間戳 (如 -86400、-1.5)
+var negativeNumberPattern = regexp.MustCompile(`^-\d+(?:\.\d+)?$`)
+
+// normalizeNegativeArgs 讓負數時間戳作為位置參數，而不是被解析為短 flag
+// 第一個負數前的位置參數 (含子命令名稱) 保留在前，其後插入 flag 與 "--"，位置參數的順序不變
+// 例如 "diff -86400 0 -z UTC" 會改寫為 "diff -z UTC -- -86400 0"
+func normalizeNegativeArgs(args []string) []string {
+	if len(args) == 0 || strings.HasPrefix(args[0], cobra.ShellCompRequestCmd) {
+		return args
+	}
+	cmd, _, err := rootCmd.Find(args)
+	if err != nil {
+		return args
+	}
+	lookup := func(name string, short bool) *pflag.Flag {
+		for _, fs := range []*pflag.FlagSet{cmd.Flags(), cmd.InheritedFlags()} {
+			if short {
+				if f := fs.ShorthandLookup(name); f != nil {
+					return f
+				}
+			} else if f := fs.Lookup(name); f != nil {
+				return f
+			}
+		}
+		return nil
+	}
+	// needsValue 判斷 flag 是否會取用下一個參數作為值
+	needsValue := func(f *pflag.Flag) bool {
+		return f != nil && f.NoOptDefVal == ""
+	}
+
+	var positional, flags []string
+	firstNegative := -1
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			positional = append(positional, args[i+1:]...)
+			i = len(args)
+		case negativeNumberPattern.MatchString(arg):
+			if firstNegative < 0 {
+				firstNegative = len(positional)
+			}
+			positional = append(positional, arg)
+		case strings.HasPrefix(arg, "--"):
+			flags = append(flags, arg)
+			if !strings.Contains(arg, "=") && needsValue(lookup(arg[2:], false)) && i+1 < len(args) {
+				i++
+				flags = append(flags, args[i])
+			}
+		case strings.HasPrefix(arg, "-") && len(arg) > 1:
+			flags = append(flags, arg)
+			// 短 flag 可合併 (如 -jz)，需要值的 flag 之後的字元即為其值
+			for j := 1; j < len(arg); j++ {
+				if needsValue(lookup(arg[j:j+1], true)) {
+					if j == len(arg)-1 && i+1 < len(args) {
+						i++
+						flags = append(flags, args[i])
+					}
+					break
+				}
+			}
+		default:
+			positional = append(positional, arg)
+		}
+	}
+	if firstNegative < 0 {
+		return args
+	}
+
+	normalized := append([]string{}, positional[:firstNegative]...)
+	normalized = append(normalized, flags...)
+	normalized = append(normalized, "--")
+	return append(normalized, positional[firstNegative:]...)
+}
+
 func init() {
 	rootCmd.PersistentFlags().StringVarP(&inputFormat, "input-format", "i", "",
-		"Specify input format (unix, unix-ms, unix-us, unix-ns, rfc3339, rfc3339-nano, datetime, date, time)")
+		"Specify input format (unix, unix-s, unix-ms, unix-us, unix-ns, rfc3339, rfc3339-nano, datetime, date, time, rfc1123, http-date, rfc822, rfc2822, ansic, unixdate, go-string, iso8601, syslog, rfc5424, clf, log-datetime, klog, filetime, dotnet-ticks, ldap, cocoa, hfs, webkit, gps, jd, mjd, excel, excel1904, snowflake, ulid, uuid, ksuid, objectid, natural, Go layout, strftime pattern)")
 	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output-format", "o", "datetime",
-		"Specify output format (unix, unix-ms, unix-us, unix-ns, rfc3339, rfc3339-nano, datetime, date, time)")
-	rootCmd.PersistentFlags().StringVarP(&timezone, "timezone", "z", "",
-		"Specify timezone (e.g., UTC, Asia/Taipei)")
+		"Specify output format (unix, unix-s, unix-ms, unix-us, unix-ns, rfc3339, rfc3339-nano, datetime, date, time, rfc1123, http-date, rfc822, rfc2822, ansic, unixdate, go-string, iso-basic, iso-week, ordinal, filetime, dotnet-ticks, ldap, cocoa, hfs, webkit, gps, jd, mjd, excel, excel1904, layout:<go layout>, strftime:<pattern>)")
+	rootCmd.PersistentFlags().StringSliceVarP(&timezones, "timezone", "z", nil,
+		"Specify timezone, repeat or comma-separate for multiple zones (e.g., UTC, Asia/Taipei)")
+	rootCmd.PersistentFlags().StringVar(&relativeTo, "relative-to", "",
+		"Reference time for the relative field (default: now)")
+	rootCmd.PersistentFlags().StringVar(&granularity, "granularity", "",
+		"Smallest unit of the relative field (second, minute, hour, day, week, month, year)")
+	rootCmd.PersistentFlags().StringVar(&snowflakeEpoch, "snowflake-epoch", "",
+		"Epoch for Snowflake IDs: twitter, discord, or any supported time (default: twitter)")
+	rootCmd.PersistentFlags().BoolVar(&strictDetect, "strict", false,
+		"Fail instead of guessing when a numeric timestamp has no single plausible reading")
+	rootCmd.PersistentFlags().StringVar(&plausibleFrom, "plausible-from", "",
+		"Start of the plausible window for numeric timestamp detection (default: 1970-01-01)")
+	rootCmd.PersistentFlags().StringVar(&plausibleTo, "plausible-to", "",
+		"End of the plausible window for numeric timestamp detection (default: 2100-01-01)")
 	rootCmd.Flags().BoolVarP(&jsonOutput, "json", "j", false,
 		"Output in JSON format")
+	rootCmd.Flags().StringArrayVarP(&inputFiles, "file", "f", nil,
+		"Read timestamps line by line from file (repeatable)")
+	rootCmd.Flags().BoolVar(&continueOnErr, "continue-on-error", false,
+		"Keep converting remaining lines when a line fails in batch mode")
 
 	// 添加語言設定 flag
 	rootCmd.PersistentFlags().StringVarP(&langFlag, "lang", "l", "",
@@ -106,27 +244,38 @@ func init() {
 		return common, cobra.ShellCompDirectiveDefault
 	})
 
+	rootCmd.RegisterFlagCompletionFunc("granularity", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return converter.RelativeUnits, cobra.ShellCompDirectiveDefault
+	})
+
+	rootCmd.RegisterFlagCompletionFunc("snowflake-epoch", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		var names []string
+		for _, e := range converter.SnowflakeEpochs {
+			names = append(names, e.Name)
+		}
+		return names, cobra.ShellCompDirectiveDefault
+	})
+
 	rootCmd.RegisterFlagCompletionFunc("input-format", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		formats := []string{
-			"unix", "unix-ms", "unix-us", "unix-ns",
-			"rfc3339", "rfc3339-nano", "datetime", "date", "time",
+		var formats []string
+		for _, f := range inputFormats {
+			formats = append(formats, f.name)
 		}
-		return formats, cobra.ShellCompDirectiveDefault
+		formats = append(formats, layoutPrefix, strftimePrefix)
+		return formats, cobra.ShellCompDirectiveNoSpace
 	})
 
 	rootCmd.RegisterFlagCompletionFunc("output-format", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		formats := []string{
-			"unix", "unix-ms", "unix-us", "unix-ns",
-			"rfc3339", "rfc3339-nano", "datetime", "date", "time",
-		}
-		return formats, cobra.ShellCompDirectiveDefault
+		formats := append([]string{}, outputFormatNames...)
+		formats = append(formats, layoutPrefix, strftimePrefix)
+		return formats, cobra.ShellCompDirectiveNoSpace
 	})
 }
 
 func convertTimestamp(cmd *cobra.Command, args []string) error {
-	conv, err := converter.NewConverter(timezone)
+	conv, err := newConverter()
 	if err != nil {
-		return fmt.Errorf("failed to create converter: %v", err)
+		return err
 	}
 
 	// 解析輸入格式
@@ -134,7 +283,7 @@ func convertTimestamp(cmd *cobra.Command, args []string) error {
 	if inputFormat != "" {
 		format, parseErr := parseInputFormat(inputFormat)
 		if parseErr != nil {
-			return parseErr
+			return newLocalizedError(parseErr)
 		}
 		inputFmt = &format
 	}
@@ -142,7 +291,7 @@ func convertTimestamp(cmd *cobra.Command, args []string) error {
 	// 轉換時間戳
 	result, err := conv.Convert(inputTimestamp, inputFmt)
 	if err != nil {
-		return fmt.Errorf("conversion failed: %v", err)
+		return wrapLocalizedError("error.conversion.failed", err)
 	}
 
 	// 輸出結果
@@ -154,67 +303,386 @@ func convertTimestamp(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// newConverter 依 --timezone、--relative-to、--granularity、--snowflake-epoch 與偵測相關 flag 建立轉換器
+// 指定多個時區時，第一個時區為主要時區，所有時區都會列在結果的 Zones 中
+func newConverter() (*converter.Converter, error) {
+	locs, err := converter.LoadLocations(timezones)
+	if err != nil {
+		return nil, wrapLocalizedError("error.converter.create", err)
+	}
+
+	conv, err := converter.NewConverter("")
+	if err != nil {
+		return nil, wrapLocalizedError("error.converter.create", err)
+	}
+	if len(locs) > 0 {
+		conv.Location = locs[0]
+	}
+	if len(locs) > 1 {
+		conv.Zones = locs
+	}
+	conv.Granularity = granularity
+
+	if relativeTo != "" {
+		ref, err := conv.Convert(relativeTo, nil)
+		if err != nil {
+			return nil, wrapLocalizedError("error.invalid.flag", err, map[string]interface{}{"Flag": "--relative-to"})
+		}
+		conv.Now = func() time.Time { return ref.Time }
+	}
+
+	if snowflakeEpoch != "" {
+		epoch, err := parseSnowflakeEpoch(conv, snowflakeEpoch)
+		if err != nil {
+			return nil, err
+		}
+		conv.Snowflake = epoch
+	}
+
+	for _, w := range []struct {
+		flag  string
+		value string
+		dst   *time.Time
+	}{
+		{"--plausible-from", plausibleFrom, &conv.PlausibleStart},
+		{"--plausible-to", plausibleTo, &conv.PlausibleEnd},
+	} {
+		if w.value == "" {
+			continue
+		}
+		result, err := conv.Convert(w.value, nil)
+		if err != nil {
+			return nil, wrapLocalizedError("error.invalid.flag", err, map[string]interface{}{"Flag": w.flag})
+		}
+		*w.dst = result.Time
+	}
+	conv.Strict = strictDetect
+	return conv, nil
+}
+
+// parseSnowflakeEpoch 解析 --snowflake-epoch，接受內建紀元名稱或任何可轉換的時間
+func parseSnowflakeEpoch(conv *converter.Converter, value string) (converter.SnowflakeEpoch, error) {
+	if epoch, ok := converter.LookupSnowflakeEpoch(value); ok {
+		return epoch, nil
+	}
+	result, err := conv.Convert(value, nil)
+	if err != nil {
+		return converter.SnowflakeEpoch{}, wrapLocalizedError("error.invalid.flag", err, map[string]interface{}{"Flag": "--snowflake-epoch"})
+	}
+	return converter.CustomSnowflakeEpoch(result.Time), nil
+}
+
+// inputFormats --input-format 支援的格式關鍵字，同一格式的第一個名稱為正式名稱
+var inputFormats = []struct {
+	name   string
+	format converter.TimestampFormat
+}{
+	{"unix", converter.UnixSeconds()},
+	{"unix-s", converter.UnixSeconds()},
+	{"unix-ms", converter.UnixMilliseconds()},
+	{"unix-us", converter.UnixMicroseconds()},
+	{"unix-ns", converter.UnixNanoseconds()},
+	{"rfc3339", converter.RFC3339()},
+	{"rfc3339-nano", converter.RFC3339Nano()},
+	{"datetime", converter.DateTime()},
+	{"date", converter.DateOnly()},
+	{"time", converter.TimeOnly()},
+	{"rfc1123", converter.RFC1123()},
+	{"http-date", converter.RFC1123()},
+	{"rfc822", converter.RFC822()},
+	{"rfc2822", converter.RFC822()},
+	{"ansic", converter.ANSIC()},
+	{"unixdate", converter.UnixDate()},
+	{"go-string", converter.GoString()},
+	{"iso8601", converter.ISO8601()},
+	{"iso", converter.ISO8601()},
+	{"syslog", converter.Syslog()},
+	{"rfc3164", converter.Syslog()},
+	{"rfc5424", converter.RFC3339Nano()},
+	{"clf", converter.CLF()},
+	{"apache", converter.CLF()},
+	{"nginx", converter.CLF()},
+	{"log-datetime", converter.LogDateTime()},
+	{"nginx-error", converter.LogDateTime()},
+	{"go-log", converter.LogDateTime()},
+	{"klog", converter.Klog()},
+	{"glog", converter.Klog()},
+	{"natural", converter.NaturalLanguage()},
+	{"filetime", converter.FileTime()},
+	{"dotnet-ticks", converter.DotNetTicks()},
+	{"ldap", converter.LDAP()},
+	{"cocoa", converter.Cocoa()},
+	{"hfs", converter.HFSPlus()},
+	{"webkit", converter.WebKit()},
+	{"gps", converter.GPS()},
+	{"jd", converter.JulianDay()},
+	{"mjd", converter.ModifiedJulianDay()},
+	{"excel", converter.ExcelSerial()},
+	{"excel1904", converter.ExcelSerial1904()},
+	{"snowflake", converter.Snowflake()},
+	{"ulid", converter.ULID()},
+	{"uuid", converter.UUID()},
+	{"ksuid", converter.KSUID()},
+	{"objectid", converter.ObjectID()},
+}
+
+// inputFormatName 取得格式的 --input-format 關鍵字，自訂格式回傳空字串
+func inputFormatName(format converter.TimestampFormat) string {
+	for _, f := range inputFormats {
+		if f.format == format {
+			return f.name
+		}
+	}
+	return ""
+}
+
+// formatLabel 取得格式在翻譯器語言中的顯示名稱，自訂格式附上原始樣式
+func formatLabel(tr *i18n.Translator, format converter.TimestampFormat) string {
+	if pattern, kind := format.Pattern(); kind != "" {
+		return tr.T("format."+kind, map[string]interface{}{"Pattern": pattern})
+	}
+	if name := inputFormatName(format); name != "" {
+		return tr.T("format." + name)
+	}
+	return tr.T("format.unknown")
+}
+
 func parseInputFormat(format string) (converter.TimestampFormat, error) {
-	switch format {
-	case "unix":
-		return converter.UnixSeconds, nil
-	case "unix-ms":
-		return converter.UnixMilliseconds, nil
-	case "unix-us":
-		return converter.UnixMicroseconds, nil
-	case "unix-ns":
-		return converter.UnixNanoseconds, nil
-	case "rfc3339":
-		return converter.RFC3339, nil
-	case "rfc3339-nano":
-		return converter.RFC3339Nano, nil
-	case "datetime":
-		return converter.DateTime, nil
-	case "date":
-		return converter.DateOnly, nil
-	case "time":
-		return converter.TimeOnly, nil
-	default:
-		return 0, fmt.Errorf("unsupported format: %s", format)
+	for _, f := range inputFormats {
+		if f.name == format {
+			return f.format, nil
+		}
+	}
+
+	// 自訂版面: layout:<Go 版面>、strftime:<樣式>，或直接給定 Go 版面 / strftime 樣式
+	switch {
+	case strings.HasPrefix(format, layoutPrefix):
+		return converter.LayoutFormat(strings.TrimPrefix(format, layoutPrefix))
+	case strings.HasPrefix(format, strftimePrefix):
+		return converter.StrftimeFormat(strings.TrimPrefix(format, strftimePrefix))
+	case strings.Contains(format, "%"):
+		return converter.StrftimeFormat(format)
 	}
+	if custom, err := converter.LayoutFormat(format); err == nil {
+		return custom, nil
+	}
+	return converter.TimestampFormat{}, &converter.InputError{Kind: converter.ErrUnsupportedFormat, Value: format, Position: -1}
 }
 
 func outputText(result *converter.ConvertResult) {
-	fmt.Printf("Original Input: %s\n", result.Original)
-	fmt.Printf("Detected Format: %s\n", result.DetectedFormat)
+	tr := i18n.Current()
+	localizeResult(tr, result)
+	writeText(os.Stdout, tr, result)
+}
+
+// writeText 以翻譯器的語言輸出轉換結果，標籤與表格欄位依顯示寬度對齊
+func writeText(w io.Writer, tr *i18n.Translator, result *converter.ConvertResult) {
+	writeFields(w, [][2]string{
+		{tr.T("label.original"), result.Original},
+		{tr.T("label.detected.format"), result.DetectedFormat},
+		{tr.T("label.converted"), formatConverted(result)},
+		{tr.T("label.unix.timestamp"), strconv.FormatInt(result.UnixSeconds, 10)},
+		{tr.T("label.relative"), result.Relative.Text},
+		{tr.T("label.weekday"), result.Weekday},
+		{tr.T("label.localized"), result.Localized},
+		{tr.T("label.timezone"), result.Timezone},
+	})
+
+	// 只有無法確定時才在文字輸出列出候選，JSON 輸出一律包含
+	if len(result.Candidates) > 1 && converter.Ambiguous(result.Candidates) {
+		fmt.Fprintln(w)
+		writeCandidates(w, tr, result.Candidates)
+	}
+	if result.ID != nil {
+		fmt.Fprintln(w)
+		writeID(w, tr, result.ID)
+	}
+	if len(result.Zones) > 0 {
+		fmt.Fprintln(w)
+		writeZones(w, tr, result.Zones)
+	}
+}
+
+// writeCandidates 依排名輸出數字時間戳的所有合理解讀
+func writeCandidates(w io.Writer, tr *i18n.Translator, candidates []converter.Candidate) {
+	table := &textTable{}
+	table.add(tr.T("label.candidate"), tr.T("label.time"), tr.T("label.score"))
+	for _, c := range candidates {
+		table.add(formatLabel(tr, c.Format), c.Time, strconv.FormatFloat(c.Score, 'f', 3, 64))
+	}
+	table.write(w)
+}
+
+// writeID 輸出從 ID 解出的欄位，欄位名稱沒有翻譯時維持原名
+func writeID(w io.Writer, tr *i18n.Translator, id *converter.IDInfo) {
+	table := &textTable{}
+	table.add(tr.T("label.id.type")+":", id.Type)
+	for _, f := range id.Fields {
+		name := tr.T("id.field." + f.Name)
+		if name == "id.field."+f.Name {
+			name = f.Name
+		}
+		table.add("  "+name+":", f.Value)
+	}
+	table.write(w)
+}
+
+// writeZones 以對齊的表格輸出多個時區
+func writeZones(w io.Writer, tr *i18n.Translator, zones []converter.ZoneTime) {
+	table := &textTable{}
+	table.add(tr.T("label.zone"), tr.T("label.date.time"), tr.T("label.offset"), tr.T("label.abbreviation"), tr.T("label.dst"))
+	for _, z := range zones {
+		dst := tr.T("label.no")
+		if z.IsDST {
+			dst = tr.T("label.yes")
+		}
+		table.add(z.Zone, z.DateTime, z.Offset, z.Abbreviation, dst)
+	}
+	table.write(w)
+}
+
+// 自訂輸出格式的前綴
+const (
+	layoutPrefix   = "layout:"
+	strftimePrefix = "strftime:"
+)
+
+// outputFormatNames 支援的固定輸出格式名稱
+var outputFormatNames = []string{
+	"unix", "unix-s", "unix-ms", "unix-us", "unix-ns",
+	"rfc3339", "rfc3339-nano", "datetime", "date", "time",
+	"rfc1123", "http-date", "rfc822", "rfc2822", "ansic", "unixdate", "go-string",
+	"iso-basic", "iso-week", "ordinal",
+	"filetime", "dotnet-ticks", "ldap", "cocoa", "hfs", "webkit", "gps",
+	"jd", "mjd", "excel", "excel1904",
+}
+
+// validateOutputFormat 檢查 --output-format 是否為支援的格式
+func validateOutputFormat(format string) error {
+	switch {
+	case strings.HasPrefix(format, layoutPrefix):
+		if strings.TrimPrefix(format, layoutPrefix) == "" {
+			return wrapLocalizedError("error.output.format", &converter.InputError{Kind: errEmptyOutputLayout, Value: format, Position: -1})
+		}
+		return nil
+	case strings.HasPrefix(format, strftimePrefix):
+		pattern := strings.TrimPrefix(format, strftimePrefix)
+		if pattern == "" {
+			return wrapLocalizedError("error.output.format", &converter.InputError{Kind: errEmptyOutputStrftime, Value: format, Position: -1})
+		}
+		if _, err := converter.Strftime(time.Time{}, pattern); err != nil {
+			return wrapLocalizedError("error.output.format", err)
+		}
+		return nil
+	}
+
+	for _, name := range outputFormatNames {
+		if format == name {
+			return nil
+		}
+	}
+	return wrapLocalizedError("error.output.format", &converter.InputError{Kind: converter.ErrUnsupportedFormat, Value: format, Position: -1})
+}
+
+// formatConverted 根據輸出格式取得轉換後的值
+func formatConverted(result *converter.ConvertResult) string {
+	switch {
+	case strings.HasPrefix(outputFormat, layoutPrefix):
+		return result.Time.Format(strings.TrimPrefix(outputFormat, layoutPrefix))
+	case strings.HasPrefix(outputFormat, strftimePrefix):
+		// 樣式已在 validateOutputFormat 中檢查過
+		formatted, _ := converter.Strftime(result.Time, strings.TrimPrefix(outputFormat, strftimePrefix))
+		return formatted
+	}
 
-	// 根據輸出格式選擇顯示
 	switch outputFormat {
-	case "unix":
-		fmt.Printf("Converted: %d\n", result.UnixSeconds)
+	case "unix", "unix-s":
+		return strconv.FormatInt(result.UnixSeconds, 10)
 	case "unix-ms":
-		fmt.Printf("Converted: %d\n", result.UnixMillis)
+		return strconv.FormatInt(result.UnixMillis, 10)
 	case "unix-us":
-		fmt.Printf("Converted: %d\n", result.UnixMicros)
+		return strconv.FormatInt(result.UnixMicros, 10)
 	case "unix-ns":
-		fmt.Printf("Converted: %d\n", result.UnixNanos)
+		return strconv.FormatInt(result.UnixNanos, 10)
+	case "filetime", "ldap":
+		return strconv.FormatInt(result.FileTime, 10)
+	case "dotnet-ticks":
+		return strconv.FormatInt(result.DotNetTicks, 10)
+	case "cocoa":
+		return strconv.FormatFloat(result.Cocoa, 'f', -1, 64)
+	case "hfs":
+		return strconv.FormatInt(result.HFSPlus, 10)
+	case "webkit":
+		return strconv.FormatInt(result.WebKit, 10)
+	case "gps":
+		return result.GPS.String()
+	case "jd":
+		return strconv.FormatFloat(result.JulianDay, 'f', -1, 64)
+	case "mjd":
+		return strconv.FormatFloat(result.ModifiedJulianDay, 'f', -1, 64)
+	case "excel":
+		return strconv.FormatFloat(result.ExcelSerial, 'f', -1, 64)
+	case "excel1904":
+		return strconv.FormatFloat(result.ExcelSerial1904, 'f', -1, 64)
 	case "rfc3339":
-		fmt.Printf("Converted: %s\n", result.RFC3339)
+		return result.RFC3339
 	case "rfc3339-nano":
-		fmt.Printf("Converted: %s\n", result.RFC3339Nano)
+		return result.RFC3339Nano
+	case "rfc1123":
+		return result.RFC1123
+	case "http-date":
+		return result.HTTPDate
+	case "rfc822":
+		return result.RFC822
+	case "rfc2822":
+		return result.RFC2822
+	case "ansic":
+		return result.ANSIC
+	case "unixdate":
+		return result.UnixDate
+	case "go-string":
+		return result.GoString
+	case "iso-basic":
+		return result.ISO8601Basic
+	case "iso-week":
+		return result.ISOWeek
+	case "ordinal":
+		return result.Ordinal
 	case "date":
-		fmt.Printf("Converted: %s\n", result.DateOnly)
+		return result.DateOnly
 	case "time":
-		fmt.Printf("Converted: %s\n", result.TimeOnly)
-	default: // datetime
-		fmt.Printf("Converted: %s\n", result.DateTime)
+		return result.TimeOnly
+	default: // datetime，其他名稱已在 validateOutputFormat 中排除
+		return result.DateTime
 	}
-
-	fmt.Printf("Unix Timestamp: %d\n", result.UnixSeconds)
-	fmt.Printf("Weekday: %s\n", result.Weekday)
-	fmt.Printf("Timezone: %s\n", result.Timezone)
 }
 
 func outputJSON(result *converter.ConvertResult) {
+	localizeResult(i18n.Current(), result)
 	jsonData, _ := json.MarshalIndent(result, "", "  ")
 	fmt.Println(string(jsonData))
 }
 
+// localizeResult 將轉換結果中的格式名稱、星期、完整日期與相對時間描述轉為翻譯器的語言
+func localizeResult(tr *i18n.Translator, result *converter.ConvertResult) {
+	result.DetectedFormat = formatLabel(tr, result.Format)
+	result.Weekday = tr.Weekday(result.Time.Weekday())
+	result.Localized = tr.LongDateTime(result.Time)
+	for i := range result.Candidates {
+		result.Candidates[i].Name = formatLabel(tr, result.Candidates[i].Format)
+	}
+
+	rel := &result.Relative
+	switch {
+	case rel.Value == 0:
+		rel.Text = tr.T("relative.now")
+	case rel.Future:
+		rel.Text = tr.TPlural("relative.future."+rel.Unit, rel.Value)
+	default:
+		rel.Text = tr.TPlural("relative.past."+rel.Unit, rel.Value)
+	}
+}
+
 // updateCommandDescriptions 更新命令描述為當前語言
 func updateCommandDescriptions() {
 	// 更新 root 命令
@@ -234,6 +702,30 @@ func updateCommandDescriptions() {
 	if flag := rootCmd.Flags().Lookup("json"); flag != nil {
 		flag.Usage = i18n.T("flag.json")
 	}
+	if flag := rootCmd.Flags().Lookup("file"); flag != nil {
+		flag.Usage = i18n.T("flag.file")
+	}
+	if flag := rootCmd.Flags().Lookup("continue-on-error"); flag != nil {
+		flag.Usage = i18n.T("flag.continue.on.error")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("relative-to"); flag != nil {
+		flag.Usage = i18n.T("flag.relative.to")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("granularity"); flag != nil {
+		flag.Usage = i18n.T("flag.granularity")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("snowflake-epoch"); flag != nil {
+		flag.Usage = i18n.T("flag.snowflake.epoch")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("strict"); flag != nil {
+		flag.Usage = i18n.T("flag.strict")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("plausible-from"); flag != nil {
+		flag.Usage = i18n.T("flag.plausible.from")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("plausible-to"); flag != nil {
+		flag.Usage = i18n.T("flag.plausible.to")
+	}
 	if flag := rootCmd.PersistentFlags().Lookup("lang"); flag != nil {
 		flag.Usage = i18n.T("flag.language")
 	}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vincent119/timesamp/converter"
+	"github.com/vincent119/timesamp/internal/i18n"
+)
+
+func TestNormalizeNegativeArgs(t *testing.T) {
+	tests := []struct {
+		args string
+		want string
+	}{
+		{"-86400", "-- -86400"},
+		{"-86400 -z UTC", "-z UTC -- -86400"},
+		{"-z UTC -1.5 -o rfc3339", "-z UTC -o rfc3339 -- -1.5"},
+		{"--timezone=UTC -j -86400", "--timezone=UTC -j -- -86400"},
+		{"-jz UTC -86400", "-jz UTC -- -86400"},
+		{"diff -86400 0 -z UTC", "diff -z UTC -- -86400 0"},
+		{"diff 0 -86400", "diff 0 -- -86400"},
+		// flag 的值即使是負數也不移動
+		{"--relative-to -86400 1640995200", "--relative-to -86400 1640995200"},
+		{"now --offset -1d", "now --offset -1d"},
+		{"-- -86400", "-- -86400"},
+		{"1640995200 -z UTC", "1640995200 -z UTC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got := normalizeNegativeArgs(strings.Fields(tt.args))
+			if want := strings.Fields(tt.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("normalizeNegativeArgs(%q) = %q, want %q", tt.args, got, want)
+			}
+		})
+	}
+}
+
+func TestValidateOutputFormat(t *testing.T) {
+	defer i18n.SetLanguage(i18n.GetCurrentLanguage())
+
+	tests := []struct {
+		lang   string
+		format string
+		kind   error
+		want   string
+	}{
+		{"en", "rfc3339", nil, ""},
+		{"en", "unix-s", nil, ""},
+		{"en", "strftime:%Y", nil, ""},
+		{"en", "layout:", errEmptyOutputLayout, "invalid output format: empty Go layout: layout:"},
+		{"en", "strftime:", errEmptyOutputStrftime, "invalid output format: empty strftime pattern: strftime:"},
+		{"en", "bogus", converter.ErrUnsupportedFormat, "invalid output format: unsupported format: bogus"},
+		{"zh-TW", "bogus", converter.ErrUnsupportedFormat, "無效的輸出格式: 不支援的格式: bogus"},
+		{"ja", "layout:", errEmptyOutputLayout, "無効な出力形式: Go レイアウトが空です: layout:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang+" "+tt.format, func(t *testing.T) {
+			i18n.SetLanguage(tt.lang)
+			err := validateOutputFormat(tt.format)
+			if tt.kind == nil {
+				if err != nil {
+					t.Fatalf("validateOutputFormat(%q) error = %v", tt.format, err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.kind) {
+				t.Fatalf("validateOutputFormat(%q) error = %v, want %v", tt.format, err, tt.kind)
+			}
+			if err.Error() != tt.want {
+				t.Errorf("validateOutputFormat(%q) = %q, want %q", tt.format, err.Error(), tt.want)
+			}
+		})
+	}
+}
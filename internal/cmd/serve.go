@@ -0,0 +1,448 @@
+// Package cmd contains the command-line interface for the timestamp tool
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/vincent119/timesamp/converter"
+	"github.com/vincent119/timesamp/internal/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	serveAddr            string
+	serveShutdownTimeout time.Duration
+)
+
+// serveCmd 以 HTTP API 提供轉換功能
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Run the converter as an HTTP API server",
+	Long: `Run the converter as a small HTTP service. All endpoints accept GET
+requests and return JSON; the language of relative times and error
+messages follows the Accept-Language header.
+
+Endpoints:
+  /convert?input=&tz=&format=   Convert a timestamp (format is an --input-format name)
+  /now?offset=&tz=              Current time, optionally shifted by an offset
+  /diff?from=&to=&tz=&format=   Interval between two instants
+  /detect?input=                Detect the format of a timestamp
+
+tz accepts a comma-separated list to include several zones in the result;
+without it the --timezone value (or the local timezone) is used.
+
+Examples:
+  timestamp serve --addr :8080
+  curl 'http://localhost:8080/convert?input=1642781234&tz=Asia/Taipei'`,
+	Args: cobra.NoArgs,
+	RunE: runServer,
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+	serveCmd.Flags().StringVar(&serveAddr, "addr", ":8080", "Address to listen on")
+	serveCmd.Flags().DurationVar(&serveShutdownTimeout, "shutdown-timeout", 10*time.Second,
+		"Time to wait for in-flight requests when shutting down")
+
+	// 在 PersistentPreRun 後更新 serve 命令描述
+	originalPreRun := serveCmd.PreRun
+	serveCmd.PreRun = func(cmd *cobra.Command, args []string) {
+		serveCmd.Short = i18n.T("cmd.serve.short")
+		serveCmd.Long = i18n.T("cmd.serve.long")
+		if flag := serveCmd.Flags().Lookup("addr"); flag != nil {
+			flag.Usage = i18n.T("flag.addr")
+		}
+		if flag := serveCmd.Flags().Lookup("shutdown-timeout"); flag != nil {
+			flag.Usage = i18n.T("flag.shutdown.timeout")
+		}
+		if originalPreRun != nil {
+			originalPreRun(cmd, args)
+		}
+	}
+}
+
+// runServer 啟動 HTTP 伺服器，收到 SIGINT 或 SIGTERM 時等待進行中的請求後關閉
+func runServer(cmd *cobra.Command, args []string) error {
+	api := &apiServer{
+		granularity: granularity,
+		logger:      log.New(os.Stderr, "", log.LstdFlags),
+	}
+	// Snowflake 紀元與數字偵測的設定沿用 CLI flag
+	conv, err := newConverter()
+	if err != nil {
+		return err
+	}
+	api.snowflake = conv.Snowflake
+	api.strict = conv.Strict
+	api.plausibleStart, api.plausibleEnd = conv.PlausibleStart, conv.PlausibleEnd
+	if len(timezones) > 0 {
+		locs, err := converter.LoadLocations(timezones)
+		if err != nil {
+			return err
+		}
+		if len(locs) > 0 {
+			api.timezone = locs[0].String()
+		}
+	}
+
+	// 所有端點皆為 GET 且回應很小，逾時可避免緩慢或閒置的連線占用伺服器資源
+	server := &http.Server{
+		Addr:              serveAddr,
+		Handler:           api.routes(),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       15 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+		ErrorLog:          api.logger,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errCh := make(chan error, 1)
+	go func() {
+		api.logger.Printf("listening on %s", serveAddr)
+		errCh <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	api.logger.Printf("shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("shutdown failed: %v", err)
+	}
+	return nil
+}
+
+// apiServer HTTP API 的處理器，每個請求使用獨立的轉換器與翻譯器
+type apiServer struct {
+	timezone    string                   // 未指定 tz 參數時使用的時區，空字串為本機時區
+	granularity string                   // 相對時間描述的最小單位
+	snowflake   converter.SnowflakeEpoch // 解析 Snowflake ID 的紀元
+	strict      bool                     // 數字時間戳沒有唯一合理解讀時回報錯誤
+	now         func() time.Time         // 取得目前時間，nil 時使用 time.Now
+	logger      *log.Logger
+
+	// plausibleStart、plausibleEnd 自動偵測數字時間戳的合理範圍，零值使用預設範圍
+	plausibleStart, plausibleEnd time.Time
+}
+
+// apiError API 錯誤回應
+type apiError struct {
+	Status  int    `json:"status"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+// detectResponse /detect 的回應
+type detectResponse struct {
+	Input      string            `json:"input"`
+	Format     string            `json:"format"`
+	Name       string            `json:"name"`
+	Candidates []detectCandidate `json:"candidates,omitempty"`
+}
+
+// detectCandidate 數字輸入的一種合理解讀，format 為 --input-format 關鍵字
+type detectCandidate struct {
+	Format string  `json:"format"`
+	Name   string  `json:"name"`
+	Time   string  `json:"time"`
+	Score  float64 `json:"score"`
+}
+
+// routes 建立 API 路由
+func (s *apiServer) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/convert", s.handleConvert)
+	mux.HandleFunc("/now", s.handleNow)
+	mux.HandleFunc("/diff", s.handleDiff)
+	mux.HandleFunc("/detect", s.handleDetect)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		s.writeError(w, r, s.newError(r, http.StatusNotFound, "not_found", r.URL.Path, nil))
+	})
+	return s.logRequests(s.requireGET(mux))
+}
+
+// requireGET 拒絕 GET 與 HEAD 以外的請求
+func (s *apiServer) requireGET(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			s.writeError(w, r, s.newError(r, http.StatusMethodNotAllowed, "method_not_allowed", r.Method, nil))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// statusRecorder 記錄回應狀態碼供日誌使用
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// logRequests 為每個請求輸出一行日誌
+func (s *apiServer) logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		if s.logger != nil {
+			s.logger.Printf("%s %s %s %d %s", r.RemoteAddr, r.Method, r.URL.RequestURI(), rec.status, time.Since(start))
+		}
+	})
+}
+
+// translator 依 Accept-Language 取得請求的翻譯器
+func (s *apiServer) translator(r *http.Request) *i18n.Translator {
+	return i18n.For(i18n.MatchAcceptLanguage(r.Header.Get("Accept-Language")))
+}
+
+// clock 取得目前時間
+func (s *apiServer) clock() time.Time {
+	if s.now != nil {
+		return s.now()
+	}
+	return time.Now()
+}
+
+// converter 依 tz 參數建立轉換器，多個時區以逗號分隔
+func (s *apiServer) converter(r *http.Request) (*converter.Converter, *apiError) {
+	tz := r.URL.Query().Get("tz")
+	if tz == "" {
+		tz = s.timezone
+	}
+
+	locs, err := converter.LoadLocations([]string{tz})
+	if err != nil {
+		return nil, s.newError(r, http.StatusBadRequest, "invalid_timezone", tz, err)
+	}
+
+	conv, _ := converter.NewConverter("") // 本機時區不會載入失敗
+	if len(locs) > 0 {
+		conv.Location = locs[0]
+	}
+	if len(locs) > 1 {
+		conv.Zones = locs
+	}
+	conv.Granularity = s.granularity
+	conv.Snowflake = s.snowflake
+	conv.Strict = s.strict
+	conv.PlausibleStart, conv.PlausibleEnd = s.plausibleStart, s.plausibleEnd
+	conv.Now = s.now
+	return conv, nil
+}
+
+// inputFormat 解析 format 參數，未指定時回傳 nil 代表自動偵測
+func (s *apiServer) inputFormat(r *http.Request) (*converter.TimestampFormat, *apiError) {
+	name := r.URL.Query().Get("format")
+	if name == "" {
+		return nil, nil
+	}
+	format, err := parseInputFormat(name)
+	if err != nil {
+		return nil, s.newError(r, http.StatusBadRequest, "invalid_format", name, err)
+	}
+	return &format, nil
+}
+
+// requireParams 取得必要的查詢參數
+func (s *apiServer) requireParams(r *http.Request, names ...string) ([]string, *apiError) {
+	values := make([]string, len(names))
+	for i, name := range names {
+		values[i] = r.URL.Query().Get(name)
+		if values[i] == "" {
+			return nil, s.newError(r, http.StatusBadRequest, "missing_parameter", name, nil)
+		}
+	}
+	return values, nil
+}
+
+// conversionError 將轉換錯誤對應為 API 錯誤，錯誤帶有輸入值時以其取代 input
+func (s *apiServer) conversionError(r *http.Request, input string, err error) *apiError {
+	var formatErr *converter.FormatError
+	var parseErr *converter.ParseError
+	var ambiguousErr *converter.AmbiguousError
+	switch {
+	case errors.As(err, &formatErr):
+		input = formatErr.Input
+	case errors.As(err, &ambiguousErr):
+		input = ambiguousErr.Input
+	case errors.As(err, &parseErr):
+		input = parseErr.Input
+	}
+
+	if errors.Is(err, converter.ErrAmbiguousFormat) {
+		return s.newError(r, http.StatusUnprocessableEntity, "ambiguous_input", input, err)
+	}
+	if errors.Is(err, converter.ErrUnknownFormat) {
+		return s.newError(r, http.StatusUnprocessableEntity, "unrecognized_input", input, err)
+	}
+	return s.newError(r, http.StatusUnprocessableEntity, "conversion_failed", input, err)
+}
+
+func (s *apiServer) handleConvert(w http.ResponseWriter, r *http.Request) {
+	params, apiErr := s.requireParams(r, "input")
+	if apiErr != nil {
+		s.writeError(w, r, apiErr)
+		return
+	}
+	conv, apiErr := s.converter(r)
+	if apiErr != nil {
+		s.writeError(w, r, apiErr)
+		return
+	}
+	format, apiErr := s.inputFormat(r)
+	if apiErr != nil {
+		s.writeError(w, r, apiErr)
+		return
+	}
+
+	result, err := conv.Convert(params[0], format)
+	if err != nil {
+		s.writeError(w, r, s.conversionError(r, params[0], err))
+		return
+	}
+	localizeResult(s.translator(r), result)
+	s.writeJSON(w, r, http.StatusOK, result)
+}
+
+func (s *apiServer) handleNow(w http.ResponseWriter, r *http.Request) {
+	conv, apiErr := s.converter(r)
+	if apiErr != nil {
+		s.writeError(w, r, apiErr)
+		return
+	}
+
+	// 相對時間以請求的時刻為準
+	now := s.clock().In(conv.Location)
+	conv.Now = func() time.Time { return now }
+
+	target := now
+	if offset := r.URL.Query().Get("offset"); offset != "" {
+		shifted, err := applyOffset(conv, now, offset)
+		if err != nil {
+			s.writeError(w, r, s.newError(r, http.StatusBadRequest, "invalid_offset", offset, err))
+			return
+		}
+		target = shifted
+	}
+
+	result := conv.ConvertTime(target)
+	localizeResult(s.translator(r), result)
+	s.writeJSON(w, r, http.StatusOK, result)
+}
+
+func (s *apiServer) handleDiff(w http.ResponseWriter, r *http.Request) {
+	params, apiErr := s.requireParams(r, "from", "to")
+	if apiErr != nil {
+		s.writeError(w, r, apiErr)
+		return
+	}
+	conv, apiErr := s.converter(r)
+	if apiErr != nil {
+		s.writeError(w, r, apiErr)
+		return
+	}
+	format, apiErr := s.inputFormat(r)
+	if apiErr != nil {
+		s.writeError(w, r, apiErr)
+		return
+	}
+
+	result, err := conv.Diff(params[0], params[1], format)
+	if err != nil {
+		s.writeError(w, r, s.conversionError(r, strings.Join(params, ", "), err))
+		return
+	}
+	localizeDiff(s.translator(r), result)
+	s.writeJSON(w, r, http.StatusOK, result)
+}
+
+func (s *apiServer) handleDetect(w http.ResponseWriter, r *http.Request) {
+	params, apiErr := s.requireParams(r, "input")
+	if apiErr != nil {
+		s.writeError(w, r, apiErr)
+		return
+	}
+	conv, apiErr := s.converter(r)
+	if apiErr != nil {
+		s.writeError(w, r, apiErr)
+		return
+	}
+
+	format, err := conv.DetectFormat(params[0])
+	if err != nil {
+		s.writeError(w, r, s.conversionError(r, params[0], err))
+		return
+	}
+	tr := s.translator(r)
+	resp := detectResponse{
+		Input:  params[0],
+		Format: inputFormatName(format),
+		Name:   formatLabel(tr, format),
+	}
+	for _, c := range conv.DetectCandidates(params[0]) {
+		resp.Candidates = append(resp.Candidates, detectCandidate{
+			Format: inputFormatName(c.Format),
+			Name:   formatLabel(tr, c.Format),
+			Time:   c.Time,
+			Score:  c.Score,
+		})
+	}
+	s.writeJSON(w, r, http.StatusOK, resp)
+}
+
+// newError 建立錯誤回應，訊息依請求的語言翻譯
+func (s *apiServer) newError(r *http.Request, status int, code, value string, err error) *apiError {
+	apiErr := &apiError{
+		Status:  status,
+		Code:    code,
+		Message: s.translator(r).T("server.error."+strings.ReplaceAll(code, "_", "."), map[string]interface{}{"Value": value}),
+	}
+	if err != nil {
+		apiErr.Detail = localizeError(s.translator(r), err)
+	}
+	return apiErr
+}
+
+// writeError 輸出錯誤回應
+func (s *apiServer) writeError(w http.ResponseWriter, r *http.Request, apiErr *apiError) {
+	s.writeJSON(w, r, apiErr.Status, map[string]*apiError{"error": apiErr})
+}
+
+// writeJSON 輸出 JSON 回應
+func (s *apiServer) writeJSON(w http.ResponseWriter, r *http.Request, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Content-Language", s.translator(r).Language())
+	w.WriteHeader(status)
+	if r.Method == http.MethodHead {
+		return
+	}
+	if err := json.NewEncoder(w).Encode(body); err != nil && s.logger != nil {
+		s.logger.Printf("failed to write response: %v", err)
+	}
+}
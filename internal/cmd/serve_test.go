@@ -0,0 +1,324 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vincent119/timesamp/converter"
+	"github.com/vincent119/timesamp/internal/i18n"
+)
+
+func TestMain(m *testing.M) {
+	i18n.Init()
+	os.Exit(m.Run())
+}
+
+// newTestAPI 建立固定時區與目前時間的 API 處理器
+func newTestAPI(logs io.Writer) *apiServer {
+	return &apiServer{
+		timezone: "UTC",
+		now:      func() time.Time { return time.Date(2022, 1, 24, 16, 7, 14, 0, time.UTC) },
+		logger:   log.New(logs, "", 0),
+	}
+}
+
+// newTestServer 建立測試伺服器
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(newTestAPI(io.Discard).routes())
+	t.Cleanup(server.Close)
+	return server
+}
+
+// get 發送 GET 請求並解析 JSON 回應
+func get(t *testing.T, server *httptest.Server, path, acceptLanguage string, body interface{}) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acceptLanguage != "" {
+		req.Header.Set("Accept-Language", acceptLanguage)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+	data, _ := io.ReadAll(resp.Body)
+	if err := json.Unmarshal(data, body); err != nil {
+		t.Fatalf("invalid JSON response %q: %v", data, err)
+	}
+	return resp
+}
+
+func TestServeConvert(t *testing.T) {
+	server := newTestServer(t)
+
+	var result converter.ConvertResult
+	resp := get(t, server, "/convert?input=1642781234&tz=Asia/Taipei", "", &result)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+	if result.RFC3339 != "2022-01-22T00:07:14+08:00" {
+		t.Errorf("RFC3339 = %q", result.RFC3339)
+	}
+	if result.Relative.Text != "3 days ago" {
+		t.Errorf("Relative.Text = %q, want %q", result.Relative.Text, "3 days ago")
+	}
+}
+
+func TestServeConvertWithFormatAndZones(t *testing.T) {
+	server := newTestServer(t)
+
+	var result converter.ConvertResult
+	query := url.Values{"input": {"21/01/2022"}, "format": {"%d/%m/%Y"}, "tz": {"UTC,Asia/Tokyo"}}
+	resp := get(t, server, "/convert?"+query.Encode(), "", &result)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+	if result.DateOnly != "2022-01-21" {
+		t.Errorf("DateOnly = %q", result.DateOnly)
+	}
+	if len(result.Zones) != 2 || result.Zones[1].Offset != "+09:00" {
+		t.Errorf("Zones = %+v", result.Zones)
+	}
+}
+
+func TestServeAcceptLanguage(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		lang      string
+		want      string
+		weekday   string
+		localized string
+	}{
+		{"en-US,en;q=0.9", "3 days ago", "Friday", "Friday, January 21, 2022 at 16:07:14"},
+		{"ja-JP,ja;q=0.9", "3日前", "金曜日", "2022年1月21日(金) 16:07:14"},
+		{"zh-TW", "3 天前", "星期五", "2022年1月21日 星期五 16:07:14"},
+		{"zh-CN", "3 天前", "星期五", "2022年1月21日星期五 16:07:14"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			var result converter.ConvertResult
+			resp := get(t, server, "/convert?input=1642781234", tt.lang, &result)
+			if result.Relative.Text != tt.want {
+				t.Errorf("Relative.Text = %q, want %q", result.Relative.Text, tt.want)
+			}
+			if result.Weekday != tt.weekday || result.Localized != tt.localized {
+				t.Errorf("Weekday = %q, Localized = %q, want %q, %q", result.Weekday, result.Localized, tt.weekday, tt.localized)
+			}
+			if got := resp.Header.Get("Content-Language"); got != i18n.MatchAcceptLanguage(tt.lang) {
+				t.Errorf("Content-Language = %q", got)
+			}
+		})
+	}
+}
+
+func TestServeNow(t *testing.T) {
+	server := newTestServer(t)
+
+	var result converter.ConvertResult
+	get(t, server, "/now", "", &result)
+	if result.UnixSeconds != 1643040434 {
+		t.Errorf("UnixSeconds = %d, want 1643040434", result.UnixSeconds)
+	}
+
+	get(t, server, "/now?offset=-1d6h", "", &result)
+	if result.DateTime != "2022-01-23 10:07:14" {
+		t.Errorf("DateTime with offset = %q", result.DateTime)
+	}
+	if result.Relative.Text != "1 day ago" {
+		t.Errorf("Relative.Text = %q", result.Relative.Text)
+	}
+
+	get(t, server, "/now?offset="+url.QueryEscape("tomorrow noon"), "", &result)
+	if result.DateTime != "2022-01-25 12:00:00" {
+		t.Errorf("DateTime with natural offset = %q", result.DateTime)
+	}
+}
+
+func TestServeDiff(t *testing.T) {
+	server := newTestServer(t)
+
+	var result converter.DiffResult
+	query := url.Values{"from": {"2024-01-31"}, "to": {"2024-03-01 06:00:00"}}
+	resp := get(t, server, "/diff?"+query.Encode(), "", &result)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+	if result.ISO8601 != "P1M1DT6H" {
+		t.Errorf("ISO8601 = %q, want P1M1DT6H", result.ISO8601)
+	}
+	if result.Humanized != "1 month, 1 day, 6 hours" {
+		t.Errorf("Humanized = %q", result.Humanized)
+	}
+
+	get(t, server, "/diff?"+query.Encode(), "ja", &result)
+	if result.Humanized != "1か月 1日 6時間" {
+		t.Errorf("ja Humanized = %q", result.Humanized)
+	}
+}
+
+func TestServeDetect(t *testing.T) {
+	server := newTestServer(t)
+
+	var result detectResponse
+	get(t, server, "/detect?input=1642781234567", "", &result)
+	if result.Format != "unix-ms" {
+		t.Errorf("Format = %q, want unix-ms", result.Format)
+	}
+	if result.Name != "Unix timestamp (milliseconds)" {
+		t.Errorf("Name = %q, want Unix timestamp (milliseconds)", result.Name)
+	}
+
+	get(t, server, "/detect?input=1642781234567", "ja", &result)
+	if result.Name != "Unix タイムスタンプ (ミリ秒)" {
+		t.Errorf("ja Name = %q", result.Name)
+	}
+}
+
+func TestServeDetectCandidates(t *testing.T) {
+	server := newTestServer(t)
+
+	var result detectResponse
+	get(t, server, "/detect?input=132872548340000000", "", &result)
+	if result.Format != "filetime" || len(result.Candidates) != 2 {
+		t.Fatalf("result = %+v, want filetime with 2 candidates", result)
+	}
+	if c := result.Candidates[1]; c.Format != "unix-ns" || c.Time != "1974-03-18T21:02:28.34Z" {
+		t.Errorf("second candidate = %+v", c)
+	}
+}
+
+func TestServeStrict(t *testing.T) {
+	api := newTestAPI(io.Discard)
+	api.strict = true
+	server := httptest.NewServer(api.routes())
+	t.Cleanup(server.Close)
+
+	var body struct {
+		Error apiError `json:"error"`
+	}
+	resp := get(t, server, "/convert?input=116444736000000000", "", &body)
+	if resp.StatusCode != http.StatusUnprocessableEntity || body.Error.Code != "ambiguous_input" {
+		t.Errorf("status = %d, code = %q, want 422 ambiguous_input", resp.StatusCode, body.Error.Code)
+	}
+	if body.Error.Message != "Ambiguous numeric timestamp: 116444736000000000" {
+		t.Errorf("message = %q", body.Error.Message)
+	}
+	// /now 不經過數字偵測，範圍外的偏移與次秒精確度皆保留
+	var result converter.ConvertResult
+	resp = get(t, server, "/now?offset=-60y", "", &result)
+	if resp.StatusCode != http.StatusOK || result.DateTime != "1962-01-24 16:07:14" {
+		t.Errorf("status = %d, DateTime = %q", resp.StatusCode, result.DateTime)
+	}
+	if result.Candidates != nil {
+		t.Errorf("Candidates = %+v, want nil", result.Candidates)
+	}
+}
+
+func TestServeErrors(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		name   string
+		path   string
+		lang   string
+		status int
+		code   string
+		msg    string
+	}{
+		{"missing input", "/convert", "", http.StatusBadRequest, "missing_parameter", "Missing required parameter: input"},
+		{"missing to", "/diff?from=1642781234", "", http.StatusBadRequest, "missing_parameter", "Missing required parameter: to"},
+		{"invalid timezone", "/convert?input=1642781234&tz=Mars/Base", "", http.StatusBadRequest, "invalid_timezone", "Invalid timezone: Mars/Base"},
+		{"invalid format", "/convert?input=1642781234&format=bogus", "", http.StatusBadRequest, "invalid_format", "Unsupported input format: bogus"},
+		{"invalid offset", "/now?offset=sometime", "", http.StatusBadRequest, "invalid_offset", "Invalid time offset: sometime"},
+		{"unrecognized input", "/convert?input=garbage", "", http.StatusUnprocessableEntity, "unrecognized_input", "Unrecognised time format: garbage"},
+		{"parse failure", "/convert?input=2022-13-45&format=date", "", http.StatusUnprocessableEntity, "conversion_failed", "Failed to convert: 2022-13-45"},
+		{"diff reports failing input", "/diff?from=1642781234&to=garbage", "", http.StatusUnprocessableEntity, "unrecognized_input", "Unrecognised time format: garbage"},
+		{"unknown path", "/nope", "", http.StatusNotFound, "not_found", "Not found: /nope"},
+		{"localized message", "/convert", "ja", http.StatusBadRequest, "missing_parameter", "必須パラメータがありません: input"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body struct {
+				Error apiError `json:"error"`
+			}
+			resp := get(t, server, tt.path, tt.lang, &body)
+			if resp.StatusCode != tt.status || body.Error.Status != tt.status {
+				t.Errorf("status = %d (body %d), want %d", resp.StatusCode, body.Error.Status, tt.status)
+			}
+			if body.Error.Code != tt.code {
+				t.Errorf("code = %q, want %q", body.Error.Code, tt.code)
+			}
+			if body.Error.Message != tt.msg {
+				t.Errorf("message = %q, want %q", body.Error.Message, tt.msg)
+			}
+		})
+	}
+}
+
+func TestServeErrorDetailLocalized(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		lang   string
+		detail string
+	}{
+		{"en", "cannot parse 2024-W60 as ISO 8601: invalid date: 2024-W60 (column 1)"},
+		{"ja", "2024-W60 をISO 8601 形式として解析できません: 無効な日付: 2024-W60 (1 文字目)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			var body struct {
+				Error apiError `json:"error"`
+			}
+			get(t, server, "/convert?input=2024-W60&format=iso8601", tt.lang, &body)
+			if body.Error.Detail != tt.detail {
+				t.Errorf("detail = %q, want %q", body.Error.Detail, tt.detail)
+			}
+		})
+	}
+}
+
+func TestServeMethodNotAllowed(t *testing.T) {
+	server := newTestServer(t)
+
+	resp, err := http.Post(server.URL+"/convert?input=1642781234", "text/plain", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("status = %d, want 405", resp.StatusCode)
+	}
+	if allow := resp.Header.Get("Allow"); allow != "GET, HEAD" {
+		t.Errorf("Allow = %q", allow)
+	}
+}
+
+func TestServeLogsRequests(t *testing.T) {
+	logs := &strings.Builder{}
+	handler := newTestAPI(logs).routes()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/convert?input=1642781234", nil))
+	if !strings.Contains(logs.String(), "GET /convert?input=1642781234 200") {
+		t.Errorf("request log = %q", logs.String())
+	}
+}
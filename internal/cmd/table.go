@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"io"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+// textTable 以顯示寬度對齊欄位的純文字表格
+// text/tabwriter 以字元數計算寬度，中日文等全形字元會造成欄位錯位，因此改以終端機的顯示寬度對齊
+type textTable struct {
+	rows [][]string
+}
+
+// add 新增一列，最後一欄不補空白
+func (t *textTable) add(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// write 輸出表格，欄與欄之間至少間隔兩個空白
+func (t *textTable) write(w io.Writer) error {
+	var widths []int
+	for _, row := range t.rows {
+		for i, cell := range row {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], displayWidth(cell))
+		}
+	}
+
+	var sb strings.Builder
+	for _, row := range t.rows {
+		for i, cell := range row {
+			sb.WriteString(cell)
+			if i < len(row)-1 {
+				sb.WriteString(strings.Repeat(" ", widths[i]-displayWidth(cell)+2))
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// writeFields 輸出「標籤: 值」清單，值的起始位置依最寬的標籤對齊
+func writeFields(w io.Writer, fields [][2]string) error {
+	table := &textTable{}
+	for _, f := range fields {
+		table.add(f[0]+":", f[1])
+	}
+	return table.write(w)
+}
+
+// displayWidth 字串在終端機上的顯示寬度：東亞全形與寬字元佔兩格，組合字元不佔寬度
+func displayWidth(s string) int {
+	n := 0
+	for _, r := range s {
+		if unicode.In(r, unicode.Mn, unicode.Me) {
+			continue
+		}
+		switch width.LookupRune(r).Kind() {
+		case width.EastAsianWide, width.EastAsianFullwidth:
+			n += 2
+		default:
+			n++
+		}
+	}
+	return n
+}
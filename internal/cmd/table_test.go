@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vincent119/timesamp/converter"
+	"github.com/vincent119/timesamp/internal/i18n"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"Weekday", 7},
+		{"星期", 4},
+		{"Unix 時間戳", 11},
+		{"ＡＢ", 4},
+		{"ｶﾀｶﾅ", 4},
+		{"é", 1},
+	}
+	for _, tt := range tests {
+		if got := displayWidth(tt.s); got != tt.want {
+			t.Errorf("displayWidth(%q) = %d, want %d", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestTextTableAlignsWideCharacters(t *testing.T) {
+	var sb strings.Builder
+	table := &textTable{}
+	table.add("時區", "偏移", "縮寫")
+	table.add("Asia/Tokyo", "+09:00", "JST")
+	table.write(&sb)
+
+	want := "時區        偏移    縮寫\n" +
+		"Asia/Tokyo  +09:00  JST\n"
+	if sb.String() != want {
+		t.Errorf("table =\n%s\nwant\n%s", sb.String(), want)
+	}
+}
+
+func TestWriteTextLocalized(t *testing.T) {
+	conv, _ := converter.NewConverter("UTC")
+	conv.Now = func() time.Time { return time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC) }
+	result, err := conv.Convert("1709164800", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tr := i18n.For("ja")
+	localizeResult(tr, result)
+	var sb strings.Builder
+	writeText(&sb, tr, result)
+
+	want := "入力:                 1709164800\n" +
+		"検出形式:             Unix タイムスタンプ (秒)\n" +
+		"変換結果:             2024-02-29 00:00:00\n" +
+		"Unix タイムスタンプ:  1709164800\n" +
+		"相対時間:             2日前\n" +
+		"曜日:                 木曜日\n" +
+		"日時:                 2024年2月29日(木) 00:00:00\n" +
+		"タイムゾーン:         UTC (UTC, UTC+00:00)\n"
+	if sb.String() != want {
+		t.Errorf("writeText =\n%s\nwant\n%s", sb.String(), want)
+	}
+}
+
+func TestWriteTextAmbiguousCandidates(t *testing.T) {
+	conv, _ := converter.NewConverter("UTC")
+	conv.Now = func() time.Time { return time.Date(2022, 1, 24, 16, 7, 14, 0, time.UTC) }
+	result, err := conv.Convert("116444736000000000", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tr := i18n.For("en")
+	localizeResult(tr, result)
+	var sb strings.Builder
+	writeText(&sb, tr, result)
+
+	// 1973 年的納秒與 1970 年的 FILETIME 分數相近，列出候選
+	want := "\n" +
+		"Candidate                     Time                  Score\n" +
+		"Unix timestamp (nanoseconds)  1973-09-09T17:45:36Z  0.379\n" +
+		"Windows FILETIME              1970-01-01T00:00:00Z  0.332\n"
+	if !strings.HasSuffix(sb.String(), want) {
+		t.Errorf("writeText =\n%s\nwant suffix\n%s", sb.String(), want)
+	}
+}
@@ -0,0 +1,41 @@
+package i18n
+
+import (
+	"strings"
+	"time"
+)
+
+// Weekday 取得星期的完整名稱 (如 "Thursday"、"星期四"、"木曜日")
+func (t *Translator) Weekday(weekday time.Weekday) string {
+	return t.T("weekday." + strings.ToLower(weekday.String()))
+}
+
+// WeekdayShort 取得星期的簡短名稱 (如 "Thu"、"週四"、"木")
+func (t *Translator) WeekdayShort(weekday time.Weekday) string {
+	return t.T("weekday.short." + strings.ToLower(weekday.String()))
+}
+
+// Month 取得月份名稱 (如 "February"、"2月")
+func (t *Translator) Month(month time.Month) string {
+	return t.T("month." + strings.ToLower(month.String()))
+}
+
+// LongDate 依語言的完整日期樣式表示時間所在時區的日期
+// 樣式參考 CLDR，如 "Thursday, February 1, 2024"、"2024年2月1日 星期四"、"2024年2月1日(木)"
+// 日期中星期的寬度因語言而異，由 date.weekday.* 決定，各語言的模板因此使用相同的變數
+func (t *Translator) LongDate(tm time.Time) string {
+	return t.T("date.long", map[string]interface{}{
+		"Year":    tm.Year(),
+		"Month":   t.Month(tm.Month()),
+		"Day":     tm.Day(),
+		"Weekday": t.T("date.weekday." + strings.ToLower(tm.Weekday().String())),
+	})
+}
+
+// LongDateTime 依語言的樣式組合完整日期與 24 小時制時間
+func (t *Translator) LongDateTime(tm time.Time) string {
+	return t.T("datetime.long", map[string]interface{}{
+		"Date": t.LongDate(tm),
+		"Time": tm.Format("15:04:05"),
+	})
+}
@@ -0,0 +1,42 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLongDate(t *testing.T) {
+	Init()
+	tm := time.Date(2024, 2, 1, 9, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		lang     string
+		weekday  string
+		month    string
+		date     string
+		dateTime string
+	}{
+		{"en", "Thursday", "February", "Thursday, February 1, 2024", "Thursday, February 1, 2024 at 09:05:00"},
+		{"zh-TW", "星期四", "2月", "2024年2月1日 星期四", "2024年2月1日 星期四 09:05:00"},
+		{"zh-CN", "星期四", "2月", "2024年2月1日星期四", "2024年2月1日星期四 09:05:00"},
+		{"ja", "木曜日", "2月", "2024年2月1日(木)", "2024年2月1日(木) 09:05:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			tr := For(tt.lang)
+			if got := tr.Weekday(tm.Weekday()); got != tt.weekday {
+				t.Errorf("Weekday = %q, want %q", got, tt.weekday)
+			}
+			if got := tr.Month(tm.Month()); got != tt.month {
+				t.Errorf("Month = %q, want %q", got, tt.month)
+			}
+			if got := tr.LongDate(tm); got != tt.date {
+				t.Errorf("LongDate = %q, want %q", got, tt.date)
+			}
+			if got := tr.LongDateTime(tm); got != tt.dateTime {
+				t.Errorf("LongDateTime = %q, want %q", got, tt.dateTime)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ package i18n
 import (
 	"embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -16,8 +17,8 @@ import (
 var localeFS embed.FS
 
 var (
-	bundle    *i18n.Bundle
-	localizer *i18n.Localizer
+	bundle      *i18n.Bundle
+	localizer   *i18n.Localizer
 	currentLang string
 )
 
@@ -34,11 +35,13 @@ func Init() error {
 	bundle = i18n.NewBundle(language.English)
 	bundle.RegisterUnmarshalFunc("json", json.Unmarshal)
 
-	// 載入所有語言檔案
+	// 載入所有語言檔案，單一語言失敗時仍繼續載入其他語言，缺少的訊息會顯示訊息 ID
+	var errs []error
 	for _, lang := range SupportedLanguages {
 		fileName := fmt.Sprintf("locales/%s/messages.json", lang)
 		data, err := localeFS.ReadFile(fileName)
 		if err != nil {
+			errs = append(errs, fmt.Errorf("讀取 %s 失敗: %w", fileName, err))
 			continue
 		}
 
@@ -47,13 +50,14 @@ func Init() error {
 		fakeFileName := fmt.Sprintf("messages.%s.json", lang)
 		_, err = bundle.ParseMessageFileBytes(data, fakeFileName)
 		if err != nil {
+			errs = append(errs, fmt.Errorf("解析 %s 失敗: %w", fileName, err))
 			continue
 		}
 	}
 
 	// 設定預設語言
 	SetLanguage(DetectLanguage())
-	return nil
+	return errors.Join(errs...)
 }
 
 // DetectLanguage 偵測系統語言
@@ -98,18 +102,20 @@ func normalizeLanguage(lang string) string {
 	return "en"
 }
 
-// SetLanguage 設定語言
-func SetLanguage(lang string) {
-	// 驗證語言是否支援
-	supported := false
+// isSupported 判斷語言是否在支援列表中
+func isSupported(lang string) bool {
 	for _, l := range SupportedLanguages {
 		if l == lang {
-			supported = true
-			break
+			return true
 		}
 	}
+	return false
+}
 
-	if !supported {
+// SetLanguage 設定語言
+func SetLanguage(lang string) {
+	// 驗證語言是否支援
+	if !isSupported(lang) {
 		lang = "en" // 回退到英文
 	}
 
@@ -119,7 +125,17 @@ func SetLanguage(lang string) {
 
 // T 翻譯函數
 func T(messageID string, templateData ...map[string]interface{}) string {
-	if localizer == nil {
+	return translate(localizer, messageID, templateData...)
+}
+
+// TPlural 依數量選擇複數形式的翻譯函數，模板中可使用 {{.Count}}
+func TPlural(messageID string, count int, templateData ...map[string]interface{}) string {
+	return translatePlural(localizer, messageID, count, templateData...)
+}
+
+// translate 以指定的 localizer 翻譯訊息
+func translate(l *i18n.Localizer, messageID string, templateData ...map[string]interface{}) string {
+	if l == nil {
 		return messageID // 如果未初始化，返回原始 ID
 	}
 
@@ -131,7 +147,32 @@ func T(messageID string, templateData ...map[string]interface{}) string {
 		config.TemplateData = templateData[0]
 	}
 
-	translated, err := localizer.Localize(config)
+	translated, err := l.Localize(config)
+	if err != nil {
+		return messageID // 如果翻譯失敗，返回原始 ID
+	}
+
+	return translated
+}
+
+// translatePlural 以指定的 localizer 翻譯複數形式的訊息
+func translatePlural(l *i18n.Localizer, messageID string, count int, templateData ...map[string]interface{}) string {
+	if l == nil {
+		return messageID // 如果未初始化，返回原始 ID
+	}
+
+	data := map[string]interface{}{"Count": count}
+	if len(templateData) > 0 {
+		for k, v := range templateData[0] {
+			data[k] = v
+		}
+	}
+
+	translated, err := l.Localize(&i18n.LocalizeConfig{
+		MessageID:    messageID,
+		PluralCount:  count,
+		TemplateData: data,
+	})
 	if err != nil {
 		return messageID // 如果翻譯失敗，返回原始 ID
 	}
@@ -139,6 +180,63 @@ func T(messageID string, templateData ...map[string]interface{}) string {
 	return translated
 }
 
+// Translator 固定語言的翻譯器，不受 SetLanguage 影響，可在多個 goroutine 間同時使用
+type Translator struct {
+	lang      string
+	localizer *i18n.Localizer
+}
+
+// For 取得指定語言的翻譯器，不支援的語言回退到英文
+func For(lang string) *Translator {
+	if !isSupported(lang) {
+		lang = "en"
+	}
+	tr := &Translator{lang: lang}
+	if bundle != nil {
+		tr.localizer = i18n.NewLocalizer(bundle, lang)
+	}
+	return tr
+}
+
+// Current 取得目前語言的翻譯器
+func Current() *Translator {
+	return &Translator{lang: GetCurrentLanguage(), localizer: localizer}
+}
+
+// Language 取得翻譯器的語言
+func (t *Translator) Language() string {
+	return t.lang
+}
+
+// T 翻譯函數
+func (t *Translator) T(messageID string, templateData ...map[string]interface{}) string {
+	return translate(t.localizer, messageID, templateData...)
+}
+
+// TPlural 依數量選擇複數形式的翻譯函數，模板中可使用 {{.Count}}
+func (t *Translator) TPlural(messageID string, count int, templateData ...map[string]interface{}) string {
+	return translatePlural(t.localizer, messageID, count, templateData...)
+}
+
+// MatchAcceptLanguage 依 HTTP Accept-Language 標頭選出最符合的支援語言，無法比對時回傳英文
+func MatchAcceptLanguage(header string) string {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil || len(tags) == 0 {
+		return "en"
+	}
+
+	supported := make([]language.Tag, len(SupportedLanguages))
+	for i, lang := range SupportedLanguages {
+		supported[i] = language.Make(lang)
+	}
+
+	_, index, confidence := language.NewMatcher(supported).Match(tags...)
+	if confidence == language.No {
+		return "en"
+	}
+	return SupportedLanguages[index]
+}
+
 // Tf 帶格式化參數的翻譯函數
 func Tf(messageID string, templateData map[string]interface{}) string {
 	return T(messageID, templateData)
@@ -5,8 +5,9 @@ import (
 )
 
 func TestInit(t *testing.T) {
-	// Init should not panic
-	Init()
+	if err := Init(); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
 }
 
 func TestSetLanguage(t *testing.T) {
@@ -82,3 +83,71 @@ func TestLanguageSwitching(t *testing.T) {
 	t.Logf("zh-TW: %s", zhTWResult)
 	t.Logf("ja: %s", jaResult)
 }
+
+func TestTPlural(t *testing.T) {
+	Init()
+
+	tests := []struct {
+		lang  string
+		id    string
+		count int
+		want  string
+	}{
+		{"en", "relative.past.day", 1, "1 day ago"},
+		{"en", "relative.past.day", 3, "3 days ago"},
+		{"en", "relative.future.hour", 2, "in 2 hours"},
+		{"zh-TW", "relative.past.day", 3, "3 天前"},
+		{"zh-CN", "relative.future.month", 1, "1 个月后"},
+		{"ja", "relative.past.minute", 5, "5分前"},
+	}
+
+	for _, tt := range tests {
+		SetLanguage(tt.lang)
+		if got := TPlural(tt.id, tt.count); got != tt.want {
+			t.Errorf("[%s] TPlural(%q, %d) = %q, want %q", tt.lang, tt.id, tt.count, got, tt.want)
+		}
+	}
+	SetLanguage("en")
+}
+
+func TestMatchAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"", "en"},
+		{"ja", "ja"},
+		{"ja-JP,ja;q=0.9,en;q=0.8", "ja"},
+		{"zh-TW,zh;q=0.9", "zh-TW"},
+		{"zh-HK", "zh-TW"},
+		{"zh-Hans-CN", "zh-CN"},
+		{"fr-FR,zh-CN;q=0.5", "zh-CN"},
+		{"de", "en"},
+		{"en-US,en;q=0.9", "en"},
+		{"not a valid header;;", "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			if got := MatchAcceptLanguage(tt.header); got != tt.want {
+				t.Errorf("MatchAcceptLanguage(%q) = %q, want %q", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslatorIndependentOfCurrentLanguage(t *testing.T) {
+	Init()
+	SetLanguage("en")
+
+	ja := For("ja")
+	if ja.Language() != "ja" {
+		t.Errorf("For(\"ja\").Language() = %q", ja.Language())
+	}
+	if got, want := ja.T("cmd.root.short"), T("cmd.root.short"); got == want {
+		t.Errorf("Japanese translator returned the English text %q", got)
+	}
+	if For("xx").Language() != "en" {
+		t.Error("For with unsupported language should fall back to en")
+	}
+}
@@ -0,0 +1,252 @@
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+// catalogFile 每個語言目錄中唯一的翻譯檔名稱
+const catalogFile = "messages.json"
+
+// ProblemKind 翻譯檔問題的種類
+type ProblemKind string
+
+const (
+	// ProblemUnparsable 翻譯檔不是有效的 JSON 或 go-i18n 訊息格式
+	ProblemUnparsable ProblemKind = "unparsable"
+
+	// ProblemMissingFile 支援的語言缺少翻譯檔
+	ProblemMissingFile ProblemKind = "missing-file"
+
+	// ProblemUnexpectedFile locales 中不應存在的檔案或目錄，如備份檔
+	ProblemUnexpectedFile ProblemKind = "unexpected-file"
+
+	// ProblemDuplicate 同一個訊息 ID 出現多次，後者會覆蓋前者
+	ProblemDuplicate ProblemKind = "duplicate"
+
+	// ProblemMissing 英文有但翻譯沒有的訊息 ID
+	ProblemMissing ProblemKind = "missing"
+
+	// ProblemExtra 翻譯有但英文沒有的訊息 ID
+	ProblemExtra ProblemKind = "extra"
+
+	// ProblemTemplate 訊息模板語法錯誤
+	ProblemTemplate ProblemKind = "template"
+
+	// ProblemVariables 翻譯使用的模板變數與英文不同
+	ProblemVariables ProblemKind = "variables"
+)
+
+// Problem 翻譯檔檢查發現的一個問題
+type Problem struct {
+	Kind ProblemKind
+	File string // 相對於 locales 目錄的路徑，如 "ja/messages.json"
+	ID   string // 訊息 ID，檔案層級的問題為空字串
+
+	// Detail 解析或模板的錯誤訊息
+	Detail string
+
+	// Want 與 Got 為 ProblemVariables 中英文與翻譯各自使用的變數
+	Want []string
+	Got  []string
+}
+
+func (p Problem) String() string {
+	switch p.Kind {
+	case ProblemUnparsable:
+		return fmt.Sprintf("%s: 無法解析: %s", p.File, p.Detail)
+	case ProblemMissingFile:
+		return fmt.Sprintf("%s: 缺少翻譯檔", p.File)
+	case ProblemUnexpectedFile:
+		return fmt.Sprintf("%s: 不應存在的檔案", p.File)
+	case ProblemDuplicate:
+		return fmt.Sprintf("%s: %s: 重複的訊息 ID", p.File, p.ID)
+	case ProblemMissing:
+		return fmt.Sprintf("%s: %s: 缺少英文中的訊息", p.File, p.ID)
+	case ProblemExtra:
+		return fmt.Sprintf("%s: %s: 英文中沒有此訊息", p.File, p.ID)
+	case ProblemTemplate:
+		return fmt.Sprintf("%s: %s: 模板語法錯誤: %s", p.File, p.ID, p.Detail)
+	case ProblemVariables:
+		return fmt.Sprintf("%s: %s: 模板變數 [%s] 與英文 [%s] 不同",
+			p.File, p.ID, strings.Join(p.Got, ", "), strings.Join(p.Want, ", "))
+	}
+	return fmt.Sprintf("%s: %s: %s", p.File, p.ID, p.Kind)
+}
+
+// templateVariable 模板中的頂層變數，如 {{.Count}} 或 {{ .Input | printf "%q" }}
+var templateVariable = regexp.MustCompile(`\{\{-?\s*\.([A-Za-z_][A-Za-z0-9_]*)`)
+
+// catalog 解析後的單一語言翻譯檔
+type catalog struct {
+	file     string
+	ids      []string // 依檔案中的順序
+	messages map[string]*i18n.Message
+}
+
+// CheckCatalogs 檢查內嵌的翻譯檔
+func CheckCatalogs() []Problem {
+	fsys, err := fs.Sub(localeFS, "locales")
+	if err != nil {
+		return []Problem{{Kind: ProblemUnparsable, File: "locales", Detail: err.Error()}}
+	}
+	return CheckCatalogsFS(fsys)
+}
+
+// CheckCatalogsFS 檢查 fsys 中的翻譯檔，fsys 的根目錄對應 locales 目錄
+// 每個支援的語言必須只有一個 messages.json，訊息 ID 與模板變數須與英文一致
+func CheckCatalogsFS(fsys fs.FS) []Problem {
+	var problems []Problem
+
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return []Problem{{Kind: ProblemUnparsable, File: ".", Detail: err.Error()}}
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || !isSupported(entry.Name()) {
+			problems = append(problems, Problem{Kind: ProblemUnexpectedFile, File: entry.Name()})
+		}
+	}
+
+	catalogs := make(map[string]*catalog)
+	for _, lang := range SupportedLanguages {
+		files, err := fs.ReadDir(fsys, lang)
+		if err != nil {
+			problems = append(problems, Problem{Kind: ProblemMissingFile, File: path.Join(lang, catalogFile)})
+			continue
+		}
+		found := false
+		for _, f := range files {
+			if f.Name() == catalogFile && !f.IsDir() {
+				found = true
+				continue
+			}
+			problems = append(problems, Problem{Kind: ProblemUnexpectedFile, File: path.Join(lang, f.Name())})
+		}
+		if !found {
+			problems = append(problems, Problem{Kind: ProblemMissingFile, File: path.Join(lang, catalogFile)})
+			continue
+		}
+
+		c, fileProblems := loadCatalog(fsys, lang)
+		problems = append(problems, fileProblems...)
+		if c != nil {
+			catalogs[lang] = c
+		}
+	}
+
+	// 無法取得英文時沒有比較的基準
+	source := catalogs["en"]
+	if source == nil {
+		return problems
+	}
+	sourceVars := make(map[string][]string, len(source.ids))
+	for _, id := range source.ids {
+		sourceVars[id] = messageVariables(source.messages[id])
+	}
+
+	for _, lang := range SupportedLanguages {
+		c := catalogs[lang]
+		if c == nil || lang == "en" {
+			continue
+		}
+		for _, id := range source.ids {
+			msg, ok := c.messages[id]
+			if !ok {
+				problems = append(problems, Problem{Kind: ProblemMissing, File: c.file, ID: id})
+				continue
+			}
+			if got := messageVariables(msg); !slices.Equal(got, sourceVars[id]) {
+				problems = append(problems, Problem{Kind: ProblemVariables, File: c.file, ID: id, Want: sourceVars[id], Got: got})
+			}
+		}
+		for _, id := range c.ids {
+			if _, ok := source.messages[id]; !ok {
+				problems = append(problems, Problem{Kind: ProblemExtra, File: c.file, ID: id})
+			}
+		}
+	}
+	return problems
+}
+
+// loadCatalog 解析單一語言的翻譯檔，並檢查重複的訊息 ID 與模板語法
+func loadCatalog(fsys fs.FS, lang string) (*catalog, []Problem) {
+	file := path.Join(lang, catalogFile)
+	data, err := fs.ReadFile(fsys, file)
+	if err != nil {
+		return nil, []Problem{{Kind: ProblemUnparsable, File: file, Detail: err.Error()}}
+	}
+
+	// go-i18n 會讓重複的 ID 互相覆蓋，因此先從原始 JSON 取得所有 ID
+	var raw []struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, []Problem{{Kind: ProblemUnparsable, File: file, Detail: err.Error()}}
+	}
+	mf, err := i18n.ParseMessageFileBytes(data, fmt.Sprintf("messages.%s.json", lang), map[string]i18n.UnmarshalFunc{"json": json.Unmarshal})
+	if err != nil {
+		return nil, []Problem{{Kind: ProblemUnparsable, File: file, Detail: err.Error()}}
+	}
+
+	var problems []Problem
+	c := &catalog{file: file, messages: make(map[string]*i18n.Message, len(mf.Messages))}
+	for _, r := range raw {
+		if _, ok := c.messages[r.ID]; ok {
+			problems = append(problems, Problem{Kind: ProblemDuplicate, File: file, ID: r.ID})
+			continue
+		}
+		c.messages[r.ID] = nil
+		c.ids = append(c.ids, r.ID)
+	}
+	for _, msg := range mf.Messages {
+		c.messages[msg.ID] = msg
+		for _, form := range messageForms(msg) {
+			if _, err := template.New(msg.ID).Parse(form); err != nil {
+				problems = append(problems, Problem{Kind: ProblemTemplate, File: file, ID: msg.ID, Detail: err.Error()})
+				break
+			}
+		}
+	}
+	return c, problems
+}
+
+// messageForms 訊息中所有非空的複數形式，非複數訊息只有 other
+func messageForms(msg *i18n.Message) []string {
+	var forms []string
+	for _, form := range []string{msg.Zero, msg.One, msg.Two, msg.Few, msg.Many, msg.Other} {
+		if form != "" {
+			forms = append(forms, form)
+		}
+	}
+	return forms
+}
+
+// messageVariables 訊息所有複數形式使用的模板變數，已排序且不重複
+// 英文的 one 常省略 {{.Count}}，因此以所有形式的聯集比較
+func messageVariables(msg *i18n.Message) []string {
+	if msg == nil {
+		return nil
+	}
+	seen := make(map[string]bool)
+	var vars []string
+	for _, form := range messageForms(msg) {
+		for _, m := range templateVariable.FindAllStringSubmatch(form, -1) {
+			if !seen[m[1]] {
+				seen[m[1]] = true
+				vars = append(vars, m[1])
+			}
+		}
+	}
+	sort.Strings(vars)
+	return vars
+}
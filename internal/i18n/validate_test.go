@@ -0,0 +1,149 @@
+package i18n
+
+import (
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+func TestEmbeddedCatalogsAreClean(t *testing.T) {
+	for _, p := range CheckCatalogs() {
+		t.Error(p)
+	}
+}
+
+// catalogFS 以英文為基準產生四個語言的翻譯檔，overrides 可替換或新增檔案
+func catalogFS(overrides map[string]string) fstest.MapFS {
+	const en = `[
+  {"id": "hello", "translation": "Hello {{.Name}}"},
+  {"id": "relative.past.day", "one": "{{.Count}} day ago", "other": "{{.Count}} days ago"}
+]`
+	fsys := fstest.MapFS{}
+	for _, lang := range SupportedLanguages {
+		fsys[lang+"/messages.json"] = &fstest.MapFile{Data: []byte(en)}
+	}
+	for name, data := range overrides {
+		fsys[name] = &fstest.MapFile{Data: []byte(data)}
+	}
+	return fsys
+}
+
+func TestCheckCatalogsFS(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		want      []Problem
+	}{
+		{
+			name: "clean",
+		},
+		{
+			name: "plural forms may differ",
+			overrides: map[string]string{
+				"ja/messages.json": `[
+  {"id": "hello", "translation": "こんにちは {{.Name}}"},
+  {"id": "relative.past.day", "other": "{{.Count}}日前"}
+]`,
+			},
+		},
+		{
+			name: "missing extra and duplicate",
+			overrides: map[string]string{
+				"zh-TW/messages.json": `[
+  {"id": "hello", "translation": "你好 {{.Name}}"},
+  {"id": "hello", "translation": "哈囉 {{.Name}}"},
+  {"id": "bye", "translation": "再見"}
+]`,
+			},
+			want: []Problem{
+				{Kind: ProblemDuplicate, File: "zh-TW/messages.json", ID: "hello"},
+				{Kind: ProblemMissing, File: "zh-TW/messages.json", ID: "relative.past.day"},
+				{Kind: ProblemExtra, File: "zh-TW/messages.json", ID: "bye"},
+			},
+		},
+		{
+			name: "template variables",
+			overrides: map[string]string{
+				"zh-CN/messages.json": `[
+  {"id": "hello", "translation": "你好 {{.User}}"},
+  {"id": "relative.past.day", "other": "几天前"}
+]`,
+			},
+			want: []Problem{
+				{Kind: ProblemVariables, File: "zh-CN/messages.json", ID: "hello", Want: []string{"Name"}, Got: []string{"User"}},
+				{Kind: ProblemVariables, File: "zh-CN/messages.json", ID: "relative.past.day", Want: []string{"Count"}},
+			},
+		},
+		{
+			name: "template syntax",
+			overrides: map[string]string{
+				"ja/messages.json": `[
+  {"id": "hello", "translation": "こんにちは {{.Name}"},
+  {"id": "relative.past.day", "other": "{{.Count}}日前"}
+]`,
+			},
+			want: []Problem{
+				{Kind: ProblemTemplate, File: "ja/messages.json", ID: "hello"},
+			},
+		},
+		{
+			name: "unparsable",
+			overrides: map[string]string{
+				"ja/messages.json": `[{"id": "hello", "translation": "こんにちは"`,
+			},
+			want: []Problem{
+				{Kind: ProblemUnparsable, File: "ja/messages.json"},
+			},
+		},
+		{
+			name: "stray files",
+			overrides: map[string]string{
+				"ja/messages_backup.json": `[]`,
+				"README.md":               "",
+			},
+			want: []Problem{
+				{Kind: ProblemUnexpectedFile, File: "README.md"},
+				{Kind: ProblemUnexpectedFile, File: "ja/messages_backup.json"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CheckCatalogsFS(catalogFS(tt.overrides))
+			if len(got) != len(tt.want) {
+				t.Fatalf("CheckCatalogsFS() = %v, want %v", got, tt.want)
+			}
+			for i, p := range got {
+				w := tt.want[i]
+				if p.Kind != w.Kind || p.File != w.File || p.ID != w.ID ||
+					!slices.Equal(p.Want, w.Want) || !slices.Equal(p.Got, w.Got) {
+					t.Errorf("problem %d = %+v, want %+v", i, p, w)
+				}
+				if (p.Kind == ProblemUnparsable || p.Kind == ProblemTemplate) && p.Detail == "" {
+					t.Errorf("problem %d has no detail", i)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckCatalogsFSMissingFile(t *testing.T) {
+	fsys := catalogFS(nil)
+	delete(fsys, "zh-CN/messages.json")
+	fsys["zh-CN/messages.zh-CN.json"] = &fstest.MapFile{Data: []byte(`[]`)}
+
+	got := CheckCatalogsFS(fsys)
+	want := []Problem{
+		{Kind: ProblemUnexpectedFile, File: "zh-CN/messages.zh-CN.json"},
+		{Kind: ProblemMissingFile, File: "zh-CN/messages.json"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("CheckCatalogsFS() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].Kind != want[i].Kind || got[i].File != want[i].File {
+			t.Errorf("problem %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}